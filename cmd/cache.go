@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/importer"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/services"
+	"github.com/urfave/cli/v3"
+)
+
+func cacheCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "cache",
+		Usage: "manage the Redis cache",
+		Commands: []*cli.Command{
+			{
+				Name:  "warm",
+				Usage: "load the admin areas and their children into the cache (see the --cache-warm-* options)",
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					d := connect(infrastructure.LoadConfig())
+					if d.redis == nil {
+						return fmt.Errorf("redis is not configured")
+					}
+					return warmCache(ctx, d.wire())
+				},
+			},
+			{
+				Name:  "flush",
+				Usage: "delete the cached entries gapi wrote, leaving other keys in the database",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{Name: "prefix", Usage: "only delete keys starting with this prefix, e.g. admin_area:list (repeatable)"},
+				},
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					patterns := infrastructure.CachePatterns
+					if prefixes := cmd.StringSlice("prefix"); len(prefixes) > 0 {
+						patterns = make([]string, 0, len(prefixes))
+						for _, prefix := range prefixes {
+							patterns = append(patterns, prefix+"*")
+						}
+					}
+					d := connect(infrastructure.LoadConfig())
+					if d.redis == nil {
+						return fmt.Errorf("redis is not configured")
+					}
+					if failed := flushCache(ctx, d.cache, patterns); failed > 0 {
+						return fmt.Errorf("failed to flush %d of %d patterns", failed, len(patterns))
+					}
+					log.Printf("Flushed cached %v", patterns)
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// warmCache loads the configured levels and presets through the caching repository so the
+// first requests after a deploy are served from Redis
+func warmCache(ctx context.Context, d *deps) error {
+	opts, err := warmOptions(d.cfg)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Warming the cache for admin levels %v in presets %s...", opts.Levels, d.cfg.CacheWarmPresets)
+	start := time.Now()
+	result, err := services.NewCacheWarmer(d.adminAreaRepo).Warm(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("cache warm-up stopped: %w", err)
+	}
+	log.Printf("Cache warmed in %s: %d requests, %d failed", time.Since(start).Round(time.Millisecond), result.Requests, result.Failed)
+	if result.Failed > 0 {
+		return fmt.Errorf("%d of %d warm-up requests failed", result.Failed, result.Requests)
+	}
+	return nil
+}
+
+// warmOptions reads the levels, presets and concurrency of the warm-up from the configuration
+func warmOptions(cfg infrastructure.Config) (domain.WarmOptions, error) {
+	levels, err := importer.ParseLevels(cfg.CacheWarmLevels)
+	if err != nil {
+		return domain.WarmOptions{}, fmt.Errorf("invalid cache warm levels: %w", err)
+	}
+	presets, err := domain.ParseWarmPresets(cfg.CacheWarmPresets)
+	if err != nil {
+		return domain.WarmOptions{}, err
+	}
+	return domain.WarmOptions{Levels: levels, Presets: presets, Concurrency: cfg.CacheWarmConcurrency}, nil
+}
+
+// flushCache deletes the keys matching the patterns, logging failures, and returns how many failed
+func flushCache(ctx context.Context, cache *infrastructure.Cache, patterns []string) int {
+	failed := 0
+	for _, pattern := range patterns {
+		if err := cache.DeletePattern(ctx, pattern); err != nil {
+			log.Printf("Failed to clear cached %s: %v", pattern, err)
+			failed++
+		}
+	}
+	return failed
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/importer"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+	"github.com/urfave/cli/v3"
+)
+
+func importCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "import",
+		Usage: "load source data into the database",
+		Commands: []*cli.Command{
+			{
+				Name:  "gadm",
+				Usage: "load GADM boundaries from a GeoPackage or Shapefile into the admin tables",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "file", Usage: "GADM GeoPackage or Shapefile to import", Required: true},
+					&cli.StringFlag{Name: "levels", Value: "0-4", Usage: "admin levels to import, e.g. 0-4 or 1,2"},
+					&cli.StringFlag{Name: "dataset", Usage: "dataset schema to import into, empty for the unqualified tables when there is no default dataset (default: the default dataset)"},
+				},
+				Action: importGADM,
+			},
+		},
+	}
+}
+
+func refreshAdjacencyCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "refresh-adjacency",
+		Usage:     "recompute the neighbours of the given admin levels, or of all levels",
+		ArgsUsage: "[level...]",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			d := connect(infrastructure.LoadConfig()).wire()
+			if err := refreshAdjacency(ctx, d.adminAreaService, cmd.Args().Slice()); err != nil {
+				return fmt.Errorf("failed to refresh adjacency: %w", err)
+			}
+			return nil
+		},
+	}
+}
+
+// importGADM loads GADM boundaries from a file into the admin tables, e.g.
+// import gadm --file gadm_410.gpkg --levels 0-4
+func importGADM(ctx context.Context, cmd *cli.Command) error {
+	cfg := infrastructure.LoadConfig()
+
+	dataset := cfg.DefaultDataset
+	if cmd.IsSet("dataset") {
+		dataset = cmd.String("dataset")
+	}
+	if dataset != "" && !infrastructure.ValidDatasetName(dataset) {
+		return fmt.Errorf("invalid dataset name %q", dataset)
+	}
+	// The cache namespace renewed below is that of the dataset served for "", so the
+	// unqualified tables can only be imported while no default dataset replaces them
+	if dataset == "" && cfg.DefaultDataset != "" {
+		return fmt.Errorf("the unqualified tables are not served while the default dataset is %s; import into a dataset or unset GADM_DEFAULT_DATASET", cfg.DefaultDataset)
+	}
+	levels, err := importer.ParseLevels(cmd.String("levels"))
+	if err != nil {
+		return err
+	}
+
+	d := connect(cfg)
+	gadm := importer.NewGADMImporter(d.db, cfg.DatabaseURL)
+	if err := gadm.CheckTools(); err != nil {
+		return err
+	}
+	opts := importer.GADMOptions{File: cmd.String("file"), Levels: levels, Dataset: dataset}
+	if err := gadm.Import(ctx, opts); err != nil {
+		return fmt.Errorf("failed to import GADM boundaries: %w", err)
+	}
+
+	// Cached areas, cells and exports refer to the replaced rows; a new namespace misses them all at once
+	if version, err := d.cache.RenewNamespace(domain.WithDataset(ctx, dataset)); err != nil {
+		log.Printf("Failed to renew the cache namespace, run cache flush: %v", err)
+	} else if d.redis != nil {
+		log.Printf("Cache namespace of the dataset renewed to version %d", version)
+	}
+	log.Printf("Imported admin levels %v; run refresh-adjacency to recompute neighbours", levels)
+	return nil
+}
+
+// refreshAdjacency recomputes the neighbours of the admin levels given as arguments, or of all levels
+func refreshAdjacency(ctx context.Context, service ports.AdminAreaService, args []string) error {
+	levels := []int32{0, 1, 2, 3, 4}
+	if len(args) > 0 {
+		levels = make([]int32, 0, len(args))
+		for _, arg := range args {
+			level, err := strconv.ParseInt(arg, 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid admin level %q", arg)
+			}
+			levels = append(levels, int32(level))
+		}
+	}
+
+	log.Printf("Precomputing adjacency for admin levels %v...", levels)
+	if err := service.PrecomputeAdjacency(ctx, levels); err != nil {
+		return err
+	}
+	log.Println("Adjacency precomputed")
+	return nil
+}
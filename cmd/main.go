@@ -1,52 +1,87 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
 	"os"
-	"os/signal"
-	"syscall"
-
-	"github.com/hoshina-dev/gapi/internal/adapters/graph"
-	"github.com/hoshina-dev/gapi/internal/adapters/http"
-	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
-	"github.com/hoshina-dev/gapi/internal/adapters/repository"
-	"github.com/hoshina-dev/gapi/internal/core/services"
-)
 
-func main() {
-	cfg := infrastructure.LoadConfig()
+	"github.com/urfave/cli/v3"
+)
 
-	db := infrastructure.ConnectDB(cfg.DatabaseURL)
-	redisClient := infrastructure.ConnectRedis(cfg)
+// configFlag is a global flag overriding the environment variable LoadConfig reads
+type configFlag struct {
+	flag cli.Flag
+	env  string
+}
 
-	repo := repository.NewAdminAreaRepository(db)
-	cache := infrastructure.NewCache(redisClient)
-	countryRepo := repository.NewCacheAdminAreaRepository(repo, cache)
-	countryService := services.NewAdminAreaService(countryRepo)
+var configFlags = []configFlag{
+	{&cli.StringFlag{Name: "dsn", Usage: "PostgreSQL connection string"}, "DATA_SOURCE_NAME"},
+	{&cli.StringFlag{Name: "port", Usage: "port to serve HTTP on"}, "PORT"},
+	{&cli.StringFlag{Name: "cors-origins", Usage: "comma-separated origins allowed by CORS"}, "CORS_ORIGINS"},
+	{&cli.StringFlag{Name: "redis-url", Usage: "Redis address, host:port"}, "REDIS_URL"},
+	{&cli.StringFlag{Name: "redis-password", Usage: "Redis password"}, "REDIS_PASSWORD"},
+	{&cli.StringFlag{Name: "redis-db", Usage: "Redis database number"}, "REDIS_DB"},
+	{&cli.StringFlag{Name: "datasets", Usage: "comma-separated GADM dataset schemas"}, "GADM_DATASETS"},
+	{&cli.StringFlag{Name: "default-dataset", Usage: "GADM dataset serving requests naming none"}, "GADM_DEFAULT_DATASET"},
+	{&cli.BoolFlag{Name: "auto-migrate", Usage: "apply pending migrations at startup"}, "AUTO_MIGRATE"},
+	{&cli.BoolFlag{Name: "strict-tolerance", Usage: "reject tolerances without a precomputed geometry"}, "STRICT_TOLERANCE"},
+	{&cli.BoolFlag{Name: "precompute-simplified", Usage: "precompute simplified geometries at startup"}, "PRECOMPUTE_SIMPLIFIED"},
+	{&cli.BoolFlag{Name: "cache-warm", Usage: "warm the cache in the background at startup"}, "CACHE_WARM"},
+	{&cli.StringFlag{Name: "cache-warm-levels", Usage: "admin levels to warm, e.g. 0-2 or 1,2"}, "CACHE_WARM_LEVELS"},
+	{&cli.StringFlag{Name: "cache-warm-presets", Usage: "geometries to warm: full, nogeom or zoom levels, e.g. full,nogeom,4"}, "CACHE_WARM_PRESETS"},
+	{&cli.IntFlag{Name: "cache-warm-concurrency", Usage: "requests in flight while warming", HideDefault: true}, "CACHE_WARM_CONCURRENCY"},
+	{&cli.IntFlag{Name: "cache-memory-mb", Usage: "in-process cache size in MB, 0 to disable", HideDefault: true}, "CACHE_MEMORY_MB"},
+	{&cli.DurationFlag{Name: "cache-stale-ttl", Usage: "how long expired entries are served while they are refreshed, 0 to disable", HideDefault: true}, "CACHE_STALE_TTL"},
+	{&cli.BoolFlag{Name: "cache-lock", Usage: "let a single instance recompute a missed entry"}, "CACHE_LOCK"},
+}
 
-	osmLineRepo := repository.NewOSMLineRepository(db)
-	osmLineService := services.NewOSMLineService(osmLineRepo)
+func main() {
+	if err := newApp().Run(context.Background(), os.Args); err != nil {
+		log.Fatal(err)
+	}
+}
 
-	resolver := graph.NewResolver(countryService, osmLineService)
+// newApp builds the gapi command; without a subcommand it serves the API
+func newApp() *cli.Command {
+	flags := make([]cli.Flag, 0, len(configFlags))
+	for _, f := range configFlags {
+		flags = append(flags, f.flag)
+	}
 
-	app := http.SetupRouter(resolver, cfg)
+	return &cli.Command{
+		Name:    "gapi",
+		Usage:   "GraphQL API for administrative boundaries and roads",
+		Version: version,
+		Flags:   flags,
+		Before: func(ctx context.Context, cmd *cli.Command) (context.Context, error) {
+			return ctx, applyConfigFlags(cmd)
+		},
+		Action: serve,
+		Commands: []*cli.Command{
+			serveCommand(),
+			migrateCommand(),
+			importCommand(),
+			refreshAdjacencyCommand(),
+			cacheCommand(),
+			queryCommand(),
+			versionCommand(),
+		},
+	}
+}
 
-	go func() {
-		if err := app.Listen(":" + cfg.Port); err != nil {
-			log.Fatalf("Failed to start server: %v", err)
+// applyConfigFlags exports the global flags given on the command line as the environment
+// variables they override, so LoadConfig validates them like any other setting. The .env
+// file does not override variables already set.
+func applyConfigFlags(cmd *cli.Command) error {
+	for _, f := range configFlags {
+		name := f.flag.Names()[0]
+		if !cmd.IsSet(name) {
+			continue
+		}
+		if err := os.Setenv(f.env, fmt.Sprint(cmd.Value(name))); err != nil {
+			return err
 		}
-	}()
-
-	log.Printf("Server running on :%s", cfg.Port)
-	log.Printf("Connect to http://localhost:%s/ for GraphQL playground", cfg.Port)
-
-	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
-	<-quit
-
-	log.Println("Shutting down server...")
-	if err := app.Shutdown(); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
 	}
-	log.Println("Server exited")
+	return nil
 }
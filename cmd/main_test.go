@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v3"
+)
+
+func TestConfigFlags_OverrideEnvironment(t *testing.T) {
+	// Arrange
+	t.Setenv("PORT", "8080")
+	t.Setenv("STRICT_TOLERANCE", "true")
+	t.Setenv("GADM_DATASETS", "gadm36")
+	t.Setenv("CORS_ORIGINS", "https://example.com")
+	t.Setenv("CACHE_WARM_CONCURRENCY", "2")
+
+	var cfg infrastructure.Config
+	app := newApp()
+	app.Commands = []*cli.Command{{
+		Name: "config",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cfg = infrastructure.LoadConfig()
+			return nil
+		},
+	}}
+
+	// Act
+	err := app.Run(context.Background(), []string{"gapi", "--port", "9090", "--strict-tolerance=false", "--datasets", "gadm36,gadm41", "--cache-warm-concurrency", "8", "config"})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "9090", cfg.Port)
+	assert.False(t, cfg.StrictTolerance)
+	assert.Equal(t, []string{"gadm36", "gadm41"}, cfg.Datasets)
+	assert.Equal(t, 8, cfg.CacheWarmConcurrency)
+	assert.Equal(t, "https://example.com", cfg.CorsOrigins, "flags not given keep the environment")
+}
+
+func TestImportGADM_RejectsUnqualifiedTablesBehindDefaultDataset(t *testing.T) {
+	// Arrange
+	t.Setenv("GADM_DEFAULT_DATASET", "gadm41")
+
+	// Act
+	err := newApp().Run(context.Background(), []string{"gapi", "import", "gadm", "--file", "gadm.gpkg", "--dataset", ""})
+
+	// Assert
+	assert.ErrorContains(t, err, "default dataset is gadm41", "rejected before connecting to the database")
+}
+
+func TestReadDocument(t *testing.T) {
+	file := t.TempDir() + "/query.graphql"
+	assert.NoError(t, os.WriteFile(file, []byte("{ __typename }"), 0o644))
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{name: "argument", args: []string{"query", "{ a }"}, want: "{ a }"},
+		{name: "file", args: []string{"query", "--file", file}, want: "{ __typename }"},
+		{name: "argument and file", args: []string{"query", "--file", file, "{ a }"}, wantErr: true},
+		{name: "several arguments", args: []string{"query", "{", "a }"}, wantErr: true},
+		{name: "blank", args: []string{"query", "  "}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			var gotErr error
+			cmd := queryCommand()
+			cmd.Action = func(ctx context.Context, cmd *cli.Command) error {
+				got, gotErr = readDocument(cmd)
+				return nil
+			}
+
+			assert.NoError(t, cmd.Run(context.Background(), tt.args))
+			if tt.wantErr {
+				assert.Error(t, gotErr)
+				return
+			}
+			assert.NoError(t, gotErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
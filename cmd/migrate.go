@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/adapters/migrations"
+	"github.com/urfave/cli/v3"
+	"gorm.io/gorm"
+)
+
+func migrateCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "migrate",
+		Usage: "apply pending schema migrations and report what else the database lacks",
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			cfg := infrastructure.LoadConfig()
+			db := infrastructure.ConnectDB(cfg.DatabaseURL)
+			if err := migrate(ctx, db, cfg.Datasets); err != nil {
+				return fmt.Errorf("failed to migrate: %w", err)
+			}
+			// Left out of migrate, which also runs at startup, as the builds take long
+			built, err := migrations.BuildSourceIndexes(ctx, db, cfg.Datasets)
+			for _, index := range built {
+				log.Printf("Built index %s", index)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to build indexes: %w", err)
+			}
+			verifySchema(ctx, db, cfg)
+			return nil
+		},
+	}
+}
+
+// migrate applies the pending schema migrations, then prepares the dataset schemas
+func migrate(ctx context.Context, db *gorm.DB, datasets []string) error {
+	applied, err := migrations.Migrate(ctx, db)
+	for _, migration := range applied {
+		log.Printf("Applied migration %04d_%s", migration.Version, migration.Name)
+	}
+	if err != nil {
+		return err
+	}
+	if len(applied) == 0 {
+		log.Println("Database schema is up to date")
+	}
+	for _, dataset := range datasets {
+		if err := migrations.MigrateDataset(ctx, db, dataset); err != nil {
+			return fmt.Errorf("dataset %s: %w", dataset, err)
+		}
+	}
+	return nil
+}
+
+// verifySchema logs what the queries rely on but the database lacks, with how to fix it,
+// so a missing table or index is reported at startup rather than by the first query
+func verifySchema(ctx context.Context, db *gorm.DB, cfg infrastructure.Config) {
+	problems, err := migrations.Verify(ctx, db, cfg.Datasets)
+	if err != nil {
+		log.Printf("Failed to verify the database schema: %v", err)
+		return
+	}
+	for _, problem := range problems {
+		log.Printf("Database schema: missing %s", problem)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hoshina-dev/gapi/internal/adapters/graph"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+func queryCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "query",
+		Usage: "run a GraphQL query or mutation and print the JSON response",
+		Description: `The document is the argument, the file given by --file, or standard input, e.g.
+   gapi query '{ adminAreaByCode(code: "THA", adminLevel: 0) { name } }'
+   gapi query --variables '{"code": "THA"}' < query.graphql`,
+		ArgsUsage: "[document]",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "file", Aliases: []string{"f"}, Usage: "read the document from this file"},
+			&cli.StringFlag{Name: "variables", Usage: "variables as a JSON object"},
+			&cli.StringFlag{Name: "operation", Usage: "operation to run when the document has several"},
+			&cli.BoolFlag{Name: "compact", Usage: "print the response on one line"},
+		},
+		Action: runQuery,
+	}
+}
+
+// runQuery executes the document against the same services the server uses, admin
+// mutations included, and exits non-zero when the response has errors
+func runQuery(ctx context.Context, cmd *cli.Command) error {
+	document, err := readDocument(cmd)
+	if err != nil {
+		return err
+	}
+	params := &graphql.RawParams{Query: document, OperationName: cmd.String("operation")}
+	if variables := cmd.String("variables"); variables != "" {
+		if err := json.Unmarshal([]byte(variables), &params.Variables); err != nil {
+			return fmt.Errorf("invalid --variables: %w", err)
+		}
+	}
+
+	d := connect(infrastructure.LoadConfig()).wire()
+	// The shell has the configuration and so the admin rights: admin mutations are allowed
+	resp := graph.Execute(graph.WithAdmin(ctx), d.resolver(), params)
+
+	encoder := json.NewEncoder(cmd.Root().Writer)
+	if !cmd.Bool("compact") {
+		encoder.SetIndent("", "  ")
+	}
+	if err := encoder.Encode(resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return cli.Exit("", 1)
+	}
+	return nil
+}
+
+// readDocument takes the document from the argument, --file or standard input
+func readDocument(cmd *cli.Command) (string, error) {
+	var document []byte
+	var err error
+	switch {
+	case cmd.Args().Len() > 1:
+		return "", errors.New("expected a single document argument; quote the query")
+	case cmd.Args().Present() && cmd.IsSet("file"):
+		return "", errors.New("give the document as an argument or with --file, not both")
+	case cmd.Args().Present():
+		document = []byte(cmd.Args().First())
+	case cmd.IsSet("file"):
+		document, err = os.ReadFile(cmd.String("file"))
+	default:
+		document, err = io.ReadAll(cmd.Root().Reader)
+	}
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(document)) == "" {
+		return "", errors.New("no GraphQL document given")
+	}
+	return string(document), nil
+}
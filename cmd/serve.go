@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/http"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/urfave/cli/v3"
+)
+
+func serveCommand() *cli.Command {
+	return &cli.Command{
+		Name:   "serve",
+		Usage:  "serve the GraphQL API and exports over HTTP (the default)",
+		Action: serve,
+	}
+}
+
+func serve(ctx context.Context, cmd *cli.Command) error {
+	cfg := infrastructure.LoadConfig()
+	d := connect(cfg)
+
+	if cfg.AutoMigrate {
+		if err := migrate(ctx, d.db, cfg.Datasets); err != nil {
+			return fmt.Errorf("failed to migrate: %w", err)
+		}
+	}
+	d.wire()
+
+	go func() {
+		if err := d.geofenceEventService.Run(context.Background()); err != nil {
+			log.Printf("Geofence events stopped: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := d.cache.Run(context.Background()); err != nil {
+			log.Printf("Cache invalidations stopped: %v", err)
+		}
+	}()
+
+	if cfg.PrecomputeSimplified {
+		go func() {
+			log.Println("Precomputing simplified geometries...")
+			if err := d.adminAreaService.PrecomputeSimplified(context.Background(), []int32{0, 1, 2, 3, 4}); err != nil {
+				log.Printf("Failed to precompute simplified geometries: %v", err)
+				return
+			}
+			log.Println("Simplified geometries precomputed")
+		}()
+	}
+
+	if cfg.CacheWarm && (d.redis != nil || cfg.CacheMemoryMB > 0) {
+		go func() {
+			if err := warmCache(context.Background(), d); err != nil {
+				log.Printf("Failed to warm the cache: %v", err)
+			}
+		}()
+	}
+
+	verifySchema(ctx, d.db, cfg)
+
+	app := http.SetupRouter(d.resolver(), d.exportService, d.cacheService, cfg)
+
+	go func() {
+		if err := app.Listen(":" + cfg.Port); err != nil {
+			log.Fatalf("Failed to start server: %v", err)
+		}
+	}()
+
+	log.Printf("Server running on :%s", cfg.Port)
+	log.Printf("Connect to http://localhost:%s/ for GraphQL playground", cfg.Port)
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	log.Println("Shutting down server...")
+	if err := app.Shutdown(); err != nil {
+		return fmt.Errorf("server forced to shutdown: %w", err)
+	}
+	log.Println("Server exited")
+	return nil
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"github.com/hoshina-dev/gapi/internal/adapters/graph"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/adapters/repository"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+	"github.com/hoshina-dev/gapi/internal/core/services"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// deps are the adapters and services the commands share, wired as the server wires them
+type deps struct {
+	cfg      infrastructure.Config
+	db       *gorm.DB
+	redis    *redis.Client
+	cache    *infrastructure.Cache
+	datasets repository.Datasets
+
+	adminAreaRepo        ports.AdminAreaRepository
+	adminAreaService     ports.AdminAreaService
+	osmLineService       ports.OSMLineService
+	exportService        ports.ExportService
+	geofenceService      ports.GeofenceService
+	geofenceEventService ports.GeofenceEventService
+	cellService          ports.CellService
+	cacheService         ports.CacheService
+}
+
+// connect opens the database and Redis connections
+func connect(cfg infrastructure.Config) *deps {
+	db := infrastructure.ConnectDB(cfg.DatabaseURL)
+	redisClient := infrastructure.ConnectRedis(cfg)
+	return &deps{
+		cfg:      cfg,
+		db:       db,
+		redis:    redisClient,
+		cache:    infrastructure.NewCache(redisClient, cfg),
+		datasets: repository.NewDatasets(cfg.Datasets, cfg.DefaultDataset),
+	}
+}
+
+// wire builds the repositories and services on top of the connections
+func (d *deps) wire() *deps {
+	repo := repository.NewAdminAreaRepository(d.db, d.datasets)
+	d.adminAreaRepo = repository.NewCacheAdminAreaRepository(repo, d.cache)
+	d.adminAreaService = services.NewAdminAreaService(d.adminAreaRepo)
+
+	osmLineRepo := repository.NewCacheOSMLineRepository(repository.NewOSMLineRepository(d.db), d.cache)
+	d.osmLineService = services.NewOSMLineService(osmLineRepo)
+
+	d.exportService = services.NewExportService(d.adminAreaRepo, osmLineRepo, d.cache)
+
+	geofenceRepo := repository.NewGeofenceRepository(d.db, d.datasets)
+	d.geofenceService = services.NewGeofenceService(geofenceRepo)
+	d.geofenceEventService = services.NewGeofenceEventService(geofenceRepo, infrastructure.NewEventBus(d.redis))
+
+	d.cellService = services.NewCellService(d.adminAreaRepo, d.cache)
+	d.cacheService = services.NewCacheService(d.cache, d.adminAreaRepo)
+	return d
+}
+
+func (d *deps) resolver() *graph.Resolver {
+	return graph.NewResolver(d.adminAreaService, d.osmLineService, d.exportService, d.geofenceService, d.geofenceEventService, d.cellService, d.cacheService, d.cfg)
+}
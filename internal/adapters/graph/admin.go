@@ -0,0 +1,24 @@
+package graph
+
+import (
+	"context"
+	"errors"
+)
+
+type adminKey struct{}
+
+// errAdminRequired rejects admin operations of requests without the admin token
+var errAdminRequired = errors.New("admin token required")
+
+// WithAdmin marks the request as authenticated with the admin token
+func WithAdmin(ctx context.Context) context.Context {
+	return context.WithValue(ctx, adminKey{}, true)
+}
+
+// requireAdmin fails unless the request was authenticated with the admin token
+func requireAdmin(ctx context.Context) error {
+	if admin, _ := ctx.Value(adminKey{}).(bool); !admin {
+		return errAdminRequired
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Execute runs one query or mutation against the resolver without the HTTP transport,
+// for running GraphQL from the command line. Errors are returned in the response.
+func Execute(ctx context.Context, resolver *Resolver, params *graphql.RawParams) *graphql.Response {
+	exec := executor.New(NewExecutableSchema(Config{Resolvers: resolver}))
+	exec.Use(extension.Introspection{})
+	exec.Use(MetricsLoader{})
+
+	ctx = graphql.StartOperationTrace(ctx)
+	params.ReadTime = graphql.TraceTiming{Start: graphql.Now(), End: graphql.Now()}
+
+	opCtx, errs := exec.CreateOperationContext(ctx, params)
+	if errs != nil {
+		return exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), errs)
+	}
+	if opCtx.Operation.Operation == ast.Subscription {
+		return exec.DispatchError(graphql.WithOperationContext(ctx, opCtx), gqlerror.List{
+			gqlerror.Errorf("subscriptions are only served over WebSocket"),
+		})
+	}
+
+	responses, ctx := exec.DispatchOperation(ctx, opCtx)
+	return responses(ctx)
+}
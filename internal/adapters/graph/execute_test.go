@@ -0,0 +1,152 @@
+package graph_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hoshina-dev/gapi/internal/adapters/graph"
+	"github.com/hoshina-dev/gapi/internal/adapters/graph/mocks"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestResolver(adminAreaService *mocks.MockAdminAreaService) *graph.Resolver {
+	return graph.NewResolver(adminAreaService, new(mocks.MockOSMLineService), new(mocks.MockExportService), new(mocks.MockGeofenceService), new(mocks.MockGeofenceEventService), new(mocks.MockCellService), new(mocks.MockCacheService), infrastructure.Config{})
+}
+
+func TestExecute_QueryWithVariables(t *testing.T) {
+	// Arrange
+	mockService := new(mocks.MockAdminAreaService)
+	mockService.On("GetByCode", mock.Anything, "THA", int32(0), mock.Anything).Return(&domain.AdminArea{
+		ID:         1,
+		Name:       "Thailand",
+		ISOCode:    "THA",
+		AdminLevel: 0,
+	}, nil)
+
+	// Act
+	resp := graph.Execute(context.Background(), newTestResolver(mockService), &graphql.RawParams{
+		Query:     "query($code: String!) { adminAreaByCode(code: $code, adminLevel: 0) { name isoCode } }",
+		Variables: map[string]any{"code": "THA"},
+	})
+
+	// Assert
+	assert.Empty(t, resp.Errors)
+	var data struct {
+		AdminAreaByCode struct {
+			Name    string `json:"name"`
+			ISOCode string `json:"isoCode"`
+		} `json:"adminAreaByCode"`
+	}
+	assert.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Equal(t, "Thailand", data.AdminAreaByCode.Name)
+	assert.Equal(t, "THA", data.AdminAreaByCode.ISOCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestExecute_SelectsOperation(t *testing.T) {
+	// Arrange
+	query := "query A { __typename } query B { __schema { queryType { name } } }"
+
+	// Act
+	resp := graph.Execute(context.Background(), newTestResolver(new(mocks.MockAdminAreaService)), &graphql.RawParams{
+		Query:         query,
+		OperationName: "B",
+	})
+
+	// Assert
+	assert.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"__schema":{"queryType":{"name":"Query"}}}`, string(resp.Data))
+}
+
+func TestExecute_InvalidQuery(t *testing.T) {
+	// Act
+	resp := graph.Execute(context.Background(), newTestResolver(new(mocks.MockAdminAreaService)), &graphql.RawParams{
+		Query: "{ unknownField }",
+	})
+
+	// Assert
+	assert.NotEmpty(t, resp.Errors)
+	assert.Nil(t, resp.Data)
+}
+
+func TestExecute_RejectsSubscription(t *testing.T) {
+	// Act
+	resp := graph.Execute(context.Background(), newTestResolver(new(mocks.MockAdminAreaService)), &graphql.RawParams{
+		Query: "subscription { __typename }",
+	})
+
+	// Assert
+	assert.NotEmpty(t, resp.Errors)
+}
+
+func TestExecute_LoadsMetricsOncePerArea(t *testing.T) {
+	// Arrange
+	mockService := new(mocks.MockAdminAreaService)
+	mockService.On("GetAll", mock.Anything, int32(1), mock.Anything).Return([]*domain.AdminArea{
+		{ID: 10, Name: "Chiang Mai", AdminLevel: 1},
+		{ID: 11, Name: "Chiang Rai", AdminLevel: 1},
+	}, nil)
+	mockService.On("GetMetrics", mock.Anything, mock.Anything, int32(1)).Return(map[int]*domain.AdminAreaMetrics{
+		10: {AreaKm2: 20107.0},
+		11: {AreaKm2: 11678.0},
+	}, nil)
+
+	// Act
+	resp := graph.Execute(context.Background(), newTestResolver(mockService), &graphql.RawParams{
+		Query: "{ adminAreas(adminLevel: 1) { name areaKm2 perimeterKm centroid { lat } pointOnSurface { lat } bbox { minLon } } }",
+	})
+
+	// Assert
+	assert.Empty(t, resp.Errors)
+	assert.JSONEq(t, `{"adminAreas":[
+		{"name":"Chiang Mai","areaKm2":20107,"perimeterKm":0,"centroid":{"lat":0},"pointOnSurface":{"lat":0},"bbox":{"minLon":0}},
+		{"name":"Chiang Rai","areaKm2":11678,"perimeterKm":0,"centroid":{"lat":0},"pointOnSurface":{"lat":0},"bbox":{"minLon":0}}
+	]}`, string(resp.Data))
+	// Both areas are measured by one query
+	mockService.AssertNumberOfCalls(t, "GetMetrics", 1)
+	ids := mockService.Calls[1].Arguments.Get(1).([]int)
+	assert.ElementsMatch(t, []int{10, 11}, ids)
+}
+
+func TestExecute_BatchesMetricsOfManyAreas(t *testing.T) {
+	// Arrange
+	areas := make([]*domain.AdminArea, 2500)
+	metrics := make(map[int]*domain.AdminAreaMetrics, len(areas))
+	for i := range areas {
+		areas[i] = &domain.AdminArea{ID: i, AdminLevel: 2}
+		metrics[i] = &domain.AdminAreaMetrics{AreaKm2: float64(i)}
+	}
+	mockService := new(mocks.MockAdminAreaService)
+	mockService.On("GetAll", mock.Anything, int32(2), mock.Anything).Return(areas, nil)
+	mockService.On("GetMetrics", mock.Anything, mock.Anything, int32(2)).Return(metrics, nil)
+
+	// Act
+	resp := graph.Execute(context.Background(), newTestResolver(mockService), &graphql.RawParams{
+		Query: "{ adminAreas(adminLevel: 2) { areaKm2 } }",
+	})
+
+	// Assert
+	assert.Empty(t, resp.Errors)
+	var data struct {
+		AdminAreas []struct{ AreaKm2 float64 }
+	}
+	assert.NoError(t, json.Unmarshal(resp.Data, &data))
+	assert.Len(t, data.AdminAreas, len(areas))
+	for i, area := range data.AdminAreas {
+		assert.Equal(t, float64(i), area.AreaKm2)
+	}
+	// Every area is measured once, by a handful of capped batches rather than a query each
+	measured := 0
+	for _, call := range mockService.Calls[1:] {
+		batch := call.Arguments.Get(1).([]int)
+		assert.LessOrEqual(t, len(batch), 1000)
+		measured += len(batch)
+	}
+	assert.Equal(t, len(areas), measured)
+	assert.Less(t, len(mockService.Calls)-1, 100)
+}
@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// MetricsLoader is the server extension loading the metrics of each admin area once per
+// operation, however many of areaKm2, perimeterKm, centroid, pointOnSurface and bbox
+// are selected
+type MetricsLoader struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = MetricsLoader{}
+
+func (MetricsLoader) ExtensionName() string {
+	return "MetricsLoader"
+}
+
+func (MetricsLoader) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptOperation gives queries and mutations a loader of their own. Subscriptions
+// run for as long as the client stays, so they load metrics as they go.
+func (MetricsLoader) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	if graphql.GetOperationContext(ctx).Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+	loader := &metricsLoader{
+		calls:   make(map[metricsKey]*metricsCall),
+		batches: make(map[metricsBatchKey]*metricsBatch),
+	}
+	return next(context.WithValue(ctx, metricsLoaderKey{}, loader))
+}
+
+// metricsBatchWait is how long the first area of a batch waits for the field resolvers
+// of its siblings, which gqlgen runs concurrently, to join it
+const metricsBatchWait = 2 * time.Millisecond
+
+// maxMetricsBatch caps the areas measured by one query
+const maxMetricsBatch = 1000
+
+type metricsLoaderKey struct{}
+
+type metricsKey struct {
+	dataset    string
+	adminLevel int32
+	id         int
+}
+
+type metricsCall struct {
+	done    chan struct{}
+	metrics *domain.AdminAreaMetrics
+	err     error
+}
+
+// metricsBatchKey groups the areas that one query can measure
+type metricsBatchKey struct {
+	dataset    string
+	adminLevel int32
+}
+
+// metricsBatch is the areas of a dataset and level waiting to be measured together
+type metricsBatch struct {
+	ids   []int
+	calls []*metricsCall
+}
+
+// metricsLoader shares the metrics of an area between the field resolvers asking for
+// them, and measures the areas asked for at about the same time in one query
+type metricsLoader struct {
+	mu      sync.Mutex
+	calls   map[metricsKey]*metricsCall
+	batches map[metricsBatchKey]*metricsBatch
+}
+
+// areaMetrics returns the metrics of an area, loading them once per operation and in
+// batches when the MetricsLoader extension is installed
+func areaMetrics(ctx context.Context, service ports.AdminAreaService, obj *domain.AdminArea) (*domain.AdminAreaMetrics, error) {
+	ctx = domain.WithDataset(ctx, obj.Dataset)
+	loader, ok := ctx.Value(metricsLoaderKey{}).(*metricsLoader)
+	if !ok {
+		metrics, err := service.GetMetrics(ctx, []int{obj.ID}, obj.AdminLevel)
+		if err != nil {
+			return nil, err
+		}
+		return metricsOf(metrics, obj.ID)
+	}
+
+	key := metricsKey{dataset: obj.Dataset, adminLevel: obj.AdminLevel, id: obj.ID}
+	loader.mu.Lock()
+	call, loading := loader.calls[key]
+	if !loading {
+		call = &metricsCall{done: make(chan struct{})}
+		loader.calls[key] = call
+		loader.enqueue(ctx, service, metricsBatchKey{dataset: obj.Dataset, adminLevel: obj.AdminLevel}, obj.ID, call)
+	}
+	loader.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.metrics, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// enqueue adds an area to the open batch of its dataset and level, opening one that is
+// loaded after metricsBatchWait, or at once when it is full. Called with mu held.
+func (l *metricsLoader) enqueue(ctx context.Context, service ports.AdminAreaService, key metricsBatchKey, id int, call *metricsCall) {
+	batch, open := l.batches[key]
+	if !open {
+		batch = &metricsBatch{}
+		l.batches[key] = batch
+		time.AfterFunc(metricsBatchWait, func() {
+			l.mu.Lock()
+			// A full batch is already loading
+			due := l.batches[key] == batch
+			if due {
+				delete(l.batches, key)
+			}
+			l.mu.Unlock()
+			if due {
+				l.load(ctx, service, key, batch)
+			}
+		})
+	}
+	batch.ids = append(batch.ids, id)
+	batch.calls = append(batch.calls, call)
+	if len(batch.ids) >= maxMetricsBatch {
+		delete(l.batches, key)
+		go l.load(ctx, service, key, batch)
+	}
+}
+
+// load measures the areas of a batch and hands each field resolver its metrics
+func (l *metricsLoader) load(ctx context.Context, service ports.AdminAreaService, key metricsBatchKey, batch *metricsBatch) {
+	metrics, err := service.GetMetrics(ctx, batch.ids, key.adminLevel)
+	for i, call := range batch.calls {
+		if err != nil {
+			call.err = err
+		} else {
+			call.metrics, call.err = metricsOf(metrics, batch.ids[i])
+		}
+		close(call.done)
+	}
+}
+
+func metricsOf(metrics map[int]*domain.AdminAreaMetrics, id int) (*domain.AdminAreaMetrics, error) {
+	if m, ok := metrics[id]; ok {
+		return m, nil
+	}
+	return nil, fmt.Errorf("admin area %d not found", id)
+}
@@ -11,32 +11,32 @@ type MockAdminAreaService struct {
 	mock.Mock
 }
 
-func (m *MockAdminAreaService) GetAll(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	args := m.Called(ctx, adminLevel, tolerance)
+func (m *MockAdminAreaService) GetAll(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	args := m.Called(ctx, adminLevel, opts)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]*domain.AdminArea), args.Error(1)
 }
 
-func (m *MockAdminAreaService) GetByID(ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	args := m.Called(ctx, id, adminLevel, tolerance)
+func (m *MockAdminAreaService) GetByID(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	args := m.Called(ctx, id, adminLevel, opts)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domain.AdminArea), args.Error(1)
 }
 
-func (m *MockAdminAreaService) GetByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	args := m.Called(ctx, code, adminLevel, tolerance)
+func (m *MockAdminAreaService) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	args := m.Called(ctx, code, adminLevel, opts)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).(*domain.AdminArea), args.Error(1)
 }
 
-func (m *MockAdminAreaService) GetChildren(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	args := m.Called(ctx, parentCode, childLevel, tolerance)
+func (m *MockAdminAreaService) GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	args := m.Called(ctx, parentCode, childLevel, opts)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
@@ -50,3 +50,61 @@ func (m *MockAdminAreaService) FilterCoordinatesByBoundary(ctx context.Context,
 	}
 	return args.Get(0).([]*domain.Coordinate), args.Error(1)
 }
+
+func (m *MockAdminAreaService) FilterCoordinatesByGeometry(ctx context.Context, coordinates []*domain.Coordinate, geometry []byte) ([]*domain.Coordinate, error) {
+	args := m.Called(ctx, coordinates, geometry)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Coordinate), args.Error(1)
+}
+
+func (m *MockAdminAreaService) ClipByBoundaries(ctx context.Context, geometry []byte, adminLevel int32) ([]*domain.ClippedArea, error) {
+	args := m.Called(ctx, geometry, adminLevel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.ClippedArea), args.Error(1)
+}
+
+func (m *MockAdminAreaService) AggregateCoordinates(ctx context.Context, coordinates []*domain.Coordinate, adminLevel int32, parentCode *string) ([]*domain.AreaAggregate, error) {
+	args := m.Called(ctx, coordinates, adminLevel, parentCode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.AreaAggregate), args.Error(1)
+}
+
+func (m *MockAdminAreaService) GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error) {
+	args := m.Called(ctx, ids, adminLevel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int]*domain.AdminAreaMetrics), args.Error(1)
+}
+
+func (m *MockAdminAreaService) PrecomputeSimplified(ctx context.Context, adminLevels []int32) error {
+	args := m.Called(ctx, adminLevels)
+	return args.Error(0)
+}
+
+func (m *MockAdminAreaService) GetNeighbors(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminAreaNeighbor, error) {
+	args := m.Called(ctx, id, adminLevel, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.AdminAreaNeighbor), args.Error(1)
+}
+
+func (m *MockAdminAreaService) PrecomputeAdjacency(ctx context.Context, adminLevels []int32) error {
+	args := m.Called(ctx, adminLevels)
+	return args.Error(0)
+}
+
+func (m *MockAdminAreaService) Crosswalk(ctx context.Context, code string, adminLevel int32, from, to string, opts domain.GeometryOptions) ([]*domain.CrosswalkMatch, error) {
+	args := m.Called(ctx, code, adminLevel, from, to, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CrosswalkMatch), args.Error(1)
+}
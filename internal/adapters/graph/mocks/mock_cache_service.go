@@ -0,0 +1,40 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCacheService struct {
+	mock.Mock
+}
+
+func (m *MockCacheService) InvalidateLevel(ctx context.Context, adminLevel int32) ([]string, error) {
+	args := m.Called(ctx, adminLevel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockCacheService) InvalidateCode(ctx context.Context, code string, adminLevel int32) ([]string, error) {
+	args := m.Called(ctx, code, adminLevel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockCacheService) InvalidatePrefix(ctx context.Context, prefix string) ([]string, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockCacheService) RenewNamespace(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
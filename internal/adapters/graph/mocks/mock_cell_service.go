@@ -0,0 +1,28 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockCellService struct {
+	mock.Mock
+}
+
+func (m *MockCellService) Polyfill(ctx context.Context, grid domain.CellGrid, code string, adminLevel int32, resolution int) ([]string, error) {
+	args := m.Called(ctx, grid, code, adminLevel, resolution)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockCellService) CellAreas(ctx context.Context, grid domain.CellGrid, cell string, adminLevel int32) ([]*domain.CellCoverage, error) {
+	args := m.Called(ctx, grid, cell, adminLevel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.CellCoverage), args.Error(1)
+}
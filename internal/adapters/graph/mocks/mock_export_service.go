@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+	"io"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockExportService struct {
+	mock.Mock
+}
+
+func (m *MockExportService) TopoJSON(ctx context.Context, scope domain.ExportScope, quantization int) ([]byte, error) {
+	args := m.Called(ctx, scope, quantization)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockExportService) Write(ctx context.Context, scope domain.ExportScope, format domain.ExportFormat, w io.Writer) error {
+	args := m.Called(ctx, scope, format, w)
+	return args.Error(0)
+}
+
+func (m *MockExportService) WriteRoads(ctx context.Context, searchTerm string, limit int, format domain.ExportFormat, w io.Writer) error {
+	args := m.Called(ctx, searchTerm, limit, format, w)
+	return args.Error(0)
+}
+
+func (m *MockExportService) ResolveScope(ctx context.Context, scope domain.ExportScope) (domain.ExportScope, error) {
+	args := m.Called(ctx, scope)
+	return args.Get(0).(domain.ExportScope), args.Error(1)
+}
@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockGeofenceEventService struct {
+	mock.Mock
+}
+
+func (m *MockGeofenceEventService) ReportPosition(ctx context.Context, deviceID string, lat float64, lon float64, timestamp time.Time) ([]*domain.GeofenceEvent, error) {
+	args := m.Called(ctx, deviceID, lat, lon, timestamp)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GeofenceEvent), args.Error(1)
+}
+
+func (m *MockGeofenceEventService) Subscribe(ctx context.Context, filter domain.GeofenceEventFilter) (<-chan *domain.GeofenceEvent, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(<-chan *domain.GeofenceEvent), args.Error(1)
+}
+
+func (m *MockGeofenceEventService) Run(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
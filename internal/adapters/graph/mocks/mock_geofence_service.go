@@ -0,0 +1,65 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockGeofenceService struct {
+	mock.Mock
+}
+
+func (m *MockGeofenceService) Create(ctx context.Context, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Geofence), args.Error(1)
+}
+
+func (m *MockGeofenceService) Update(ctx context.Context, id int, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	args := m.Called(ctx, id, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Geofence), args.Error(1)
+}
+
+func (m *MockGeofenceService) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockGeofenceService) GetByID(ctx context.Context, id int, opts domain.GeometryOptions) (*domain.Geofence, error) {
+	args := m.Called(ctx, id, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*domain.Geofence), args.Error(1)
+}
+
+func (m *MockGeofenceService) List(ctx context.Context, opts domain.GeometryOptions) ([]*domain.Geofence, error) {
+	args := m.Called(ctx, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Geofence), args.Error(1)
+}
+
+func (m *MockGeofenceService) At(ctx context.Context, lat float64, lon float64, opts domain.GeometryOptions) ([]*domain.Geofence, error) {
+	args := m.Called(ctx, lat, lon, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.Geofence), args.Error(1)
+}
+
+func (m *MockGeofenceService) Match(ctx context.Context, coordinates []*domain.Coordinate, opts domain.GeometryOptions) ([]*domain.GeofenceMatch, error) {
+	args := m.Called(ctx, coordinates, opts)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.GeofenceMatch), args.Error(1)
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOSMLineService struct {
+	mock.Mock
+}
+
+func (m *MockOSMLineService) SearchRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.OSMLine, error) {
+	args := m.Called(ctx, searchTerm, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OSMLine), args.Error(1)
+}
+
+func (m *MockOSMLineService) GetAddressByRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.LineWithAddress, error) {
+	args := m.Called(ctx, searchTerm, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.LineWithAddress), args.Error(1)
+}
+
+func (m *MockOSMLineService) FindNearbyRoads(ctx context.Context, lat float64, lon float64, radius float64, limit int) ([]*domain.OSMLine, error) {
+	args := m.Called(ctx, lat, lon, radius, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*domain.OSMLine), args.Error(1)
+}
@@ -2,11 +2,47 @@
 
 package model
 
+type AdminAddressInput struct {
+	Country *string `json:"country,omitempty"`
+	Admin1  *string `json:"admin1,omitempty"`
+	Admin2  *string `json:"admin2,omitempty"`
+	Admin3  *string `json:"admin3,omitempty"`
+	Admin4  *string `json:"admin4,omitempty"`
+	Gid0    *string `json:"gid0,omitempty"`
+	Gid1    *string `json:"gid1,omitempty"`
+	Gid2    *string `json:"gid2,omitempty"`
+	Gid3    *string `json:"gid3,omitempty"`
+	Gid4    *string `json:"gid4,omitempty"`
+	OgcFid  *int32  `json:"ogcFid,omitempty"`
+}
+
 type CoordinateInput struct {
 	ID  string  `json:"id"`
 	Lat float64 `json:"lat"`
 	Lon float64 `json:"lon"`
+	// Optional weight, summed per area by aggregateCoordinates and ignored elsewhere
+	Value *float64 `json:"value,omitempty"`
+}
+
+// Defines a geofence by exactly one of geometry, a GeoJSON Polygon or MultiPolygon,
+// or boundaryCodes, GADM codes such as "THA.3_1" whose boundaries are unioned.
+type GeofenceInput struct {
+	Name          string         `json:"name"`
+	Description   *string        `json:"description,omitempty"`
+	Geometry      map[string]any `json:"geometry,omitempty"`
+	BoundaryCodes []string       `json:"boundaryCodes,omitempty"`
+	// GADM dataset the boundary codes are read from; defaults to the configured one
+	Dataset *string `json:"dataset,omitempty"`
 }
 
+type Mutation struct {
+}
+
+// Queries reading admin areas take an optional dataset, the GADM release to read
+// from, e.g. "gadm41"; it defaults to the configured one. Fields of an area are
+// read from the dataset the area came from.
 type Query struct {
 }
+
+type Subscription struct {
+}
@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+)
+
+// geometryOptions validates the tolerance or zoom and inspects the client's selection set
+// so the repository only serializes geometry when it is actually requested.
+func (r *Resolver) geometryOptions(ctx context.Context, tolerance *float64, zoom *int32, simplification *domain.Simplification) (domain.GeometryOptions, error) {
+	opts, err := r.simplificationOptions(tolerance, zoom, simplification)
+	if err != nil {
+		return domain.GeometryOptions{}, err
+	}
+	opts.Omit = !fieldRequested(ctx, "geometry")
+	return opts, nil
+}
+
+// neighborOptions is geometryOptions for the areas nested in AdminAreaNeighbor results
+func (r *Resolver) neighborOptions(ctx context.Context, tolerance *float64, zoom *int32, simplification *domain.Simplification) (domain.GeometryOptions, error) {
+	opts, err := r.simplificationOptions(tolerance, zoom, simplification)
+	if err != nil {
+		return domain.GeometryOptions{}, err
+	}
+	opts.Omit = !fieldRequested(ctx, "area", "geometry")
+	return opts, nil
+}
+
+// withDataset selects the GADM dataset named by a query argument, if any
+func withDataset(ctx context.Context, dataset *string) context.Context {
+	if dataset == nil {
+		return ctx
+	}
+	return domain.WithDataset(ctx, *dataset)
+}
+
+// simplificationOptions validates the tolerance or zoom together with the simplification mode
+func (r *Resolver) simplificationOptions(tolerance *float64, zoom *int32, simplification *domain.Simplification) (domain.GeometryOptions, error) {
+	validTolerance, err := resolveTolerance(tolerance, zoom, r.strictTolerance)
+	if err != nil {
+		return domain.GeometryOptions{}, err
+	}
+	mode, err := validateSimplification(simplification, validTolerance)
+	if err != nil {
+		return domain.GeometryOptions{}, err
+	}
+	return domain.GeometryOptions{
+		Tolerance:      validTolerance,
+		Simplification: mode,
+	}, nil
+}
+
+// geofenceOptions validates the tolerance and inspects the selection set at path, e.g.
+// "geofences", "geometry" when the geofences are nested in the returned objects
+func (r *Resolver) geofenceOptions(ctx context.Context, tolerance *float64, path ...string) (domain.GeometryOptions, error) {
+	validTolerance, err := validateTolerance(tolerance)
+	if err != nil {
+		return domain.GeometryOptions{}, err
+	}
+	return domain.GeometryOptions{
+		Tolerance: validTolerance,
+		Omit:      !fieldRequested(ctx, path...),
+	}, nil
+}
+
+// fieldRequested reports whether the current field's selection set includes the named
+// field, or the field at the given path of nested selections
+func fieldRequested(ctx context.Context, path ...string) bool {
+	return selectionIncludes(graphql.GetOperationContext(ctx), graphql.CollectFieldsCtx(ctx, nil), path)
+}
+
+func selectionIncludes(opCtx *graphql.OperationContext, fields []graphql.CollectedField, path []string) bool {
+	for _, field := range fields {
+		if field.Name != path[0] {
+			continue
+		}
+		if len(path) == 1 || selectionIncludes(opCtx, graphql.CollectFields(opCtx, field.Selections, nil), path[1:]) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,17 +1,32 @@
 package graph
 
-import "github.com/hoshina-dev/gapi/internal/core/ports"
+import (
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
 
 //go:generate go tool gqlgen generate
 
 type Resolver struct {
-	adminAreaService ports.AdminAreaService
-	osmLineService   ports.OSMLineService
+	adminAreaService     ports.AdminAreaService
+	osmLineService       ports.OSMLineService
+	exportService        ports.ExportService
+	geofenceService      ports.GeofenceService
+	geofenceEventService ports.GeofenceEventService
+	cellService          ports.CellService
+	cacheService         ports.CacheService
+	strictTolerance      bool
 }
 
-func NewResolver(adminAreaService ports.AdminAreaService, osmLineService ports.OSMLineService) *Resolver {
+func NewResolver(adminAreaService ports.AdminAreaService, osmLineService ports.OSMLineService, exportService ports.ExportService, geofenceService ports.GeofenceService, geofenceEventService ports.GeofenceEventService, cellService ports.CellService, cacheService ports.CacheService, cfg infrastructure.Config) *Resolver {
 	return &Resolver{
-		adminAreaService: adminAreaService,
-		osmLineService:   osmLineService,
+		adminAreaService:     adminAreaService,
+		osmLineService:       osmLineService,
+		exportService:        exportService,
+		geofenceService:      geofenceService,
+		geofenceEventService: geofenceEventService,
+		cellService:          cellService,
+		cacheService:         cacheService,
+		strictTolerance:      cfg.StrictTolerance,
 	}
 }
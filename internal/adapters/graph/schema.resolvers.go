@@ -8,7 +8,9 @@ package graph
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strconv"
+	"time"
 
 	"github.com/hoshina-dev/gapi/internal/adapters/graph/model"
 	"github.com/hoshina-dev/gapi/internal/core/domain"
@@ -23,6 +25,171 @@ func (r *adminAreaResolver) Geometry(ctx context.Context, obj *domain.AdminArea)
 	return geom, nil
 }
 
+// AreaKm2 is the resolver for the areaKm2 field.
+func (r *adminAreaResolver) AreaKm2(ctx context.Context, obj *domain.AdminArea) (float64, error) {
+	metrics, err := areaMetrics(ctx, r.adminAreaService, obj)
+	if err != nil {
+		return 0, err
+	}
+	return metrics.AreaKm2, nil
+}
+
+// PerimeterKm is the resolver for the perimeterKm field.
+func (r *adminAreaResolver) PerimeterKm(ctx context.Context, obj *domain.AdminArea) (float64, error) {
+	metrics, err := areaMetrics(ctx, r.adminAreaService, obj)
+	if err != nil {
+		return 0, err
+	}
+	return metrics.PerimeterKm, nil
+}
+
+// Centroid is the resolver for the centroid field.
+func (r *adminAreaResolver) Centroid(ctx context.Context, obj *domain.AdminArea) (*domain.Coordinate, error) {
+	metrics, err := areaMetrics(ctx, r.adminAreaService, obj)
+	if err != nil {
+		return nil, err
+	}
+	return &metrics.Centroid, nil
+}
+
+// PointOnSurface is the resolver for the pointOnSurface field.
+func (r *adminAreaResolver) PointOnSurface(ctx context.Context, obj *domain.AdminArea) (*domain.Coordinate, error) {
+	metrics, err := areaMetrics(ctx, r.adminAreaService, obj)
+	if err != nil {
+		return nil, err
+	}
+	return &metrics.PointOnSurface, nil
+}
+
+// Bbox is the resolver for the bbox field.
+func (r *adminAreaResolver) Bbox(ctx context.Context, obj *domain.AdminArea) (*domain.BBox, error) {
+	metrics, err := areaMetrics(ctx, r.adminAreaService, obj)
+	if err != nil {
+		return nil, err
+	}
+	return &metrics.BBox, nil
+}
+
+// Neighbors is the resolver for the neighbors field.
+func (r *adminAreaResolver) Neighbors(ctx context.Context, obj *domain.AdminArea, tolerance *float64, zoom *int32, simplification *domain.Simplification) ([]*domain.AdminAreaNeighbor, error) {
+	ctx = domain.WithDataset(ctx, obj.Dataset)
+	opts, err := r.neighborOptions(ctx, tolerance, zoom, simplification)
+	if err != nil {
+		return nil, err
+	}
+	return r.adminAreaService.GetNeighbors(ctx, obj.ID, obj.AdminLevel, opts)
+}
+
+// Cells is the resolver for the cells field.
+func (r *adminAreaResolver) Cells(ctx context.Context, obj *domain.AdminArea, resolution int32, grid *domain.CellGrid) ([]string, error) {
+	ctx = domain.WithDataset(ctx, obj.Dataset)
+	cellGrid := resolveCellGrid(grid)
+	validResolution, err := validateCellResolution(cellGrid, resolution)
+	if err != nil {
+		return nil, err
+	}
+	return r.cellService.Polyfill(ctx, cellGrid, obj.ISOCode, obj.AdminLevel, validResolution)
+}
+
+// Geometry is the resolver for the geometry field.
+func (r *clippedAreaResolver) Geometry(ctx context.Context, obj *domain.ClippedArea) (map[string]any, error) {
+	var geom map[string]any
+	if err := json.Unmarshal(obj.Geometry, &geom); err != nil {
+		return nil, err
+	}
+	return geom, nil
+}
+
+// Geometry is the resolver for the geometry field.
+func (r *geofenceResolver) Geometry(ctx context.Context, obj *domain.Geofence) (map[string]any, error) {
+	var geom map[string]any
+	if err := json.Unmarshal(obj.Geometry, &geom); err != nil {
+		return nil, err
+	}
+	return geom, nil
+}
+
+// CreateGeofence is the resolver for the createGeofence field.
+func (r *mutationResolver) CreateGeofence(ctx context.Context, input model.GeofenceInput) (*domain.Geofence, error) {
+	ctx = withDataset(ctx, input.Dataset)
+	definition, err := validateGeofenceInput(input)
+	if err != nil {
+		return nil, err
+	}
+	return r.geofenceService.Create(ctx, definition)
+}
+
+// UpdateGeofence is the resolver for the updateGeofence field.
+func (r *mutationResolver) UpdateGeofence(ctx context.Context, id string, input model.GeofenceInput) (*domain.Geofence, error) {
+	ctx = withDataset(ctx, input.Dataset)
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+	definition, err := validateGeofenceInput(input)
+	if err != nil {
+		return nil, err
+	}
+	return r.geofenceService.Update(ctx, id_int, definition)
+}
+
+// DeleteGeofence is the resolver for the deleteGeofence field.
+func (r *mutationResolver) DeleteGeofence(ctx context.Context, id string) (bool, error) {
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		return false, err
+	}
+	if err := r.geofenceService.Delete(ctx, id_int); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReportPosition is the resolver for the reportPosition field.
+func (r *mutationResolver) ReportPosition(ctx context.Context, deviceID string, lat float64, lon float64, timestamp *time.Time) ([]*domain.GeofenceEvent, error) {
+	if err := validatePositionReport(deviceID, lat, lon); err != nil {
+		return nil, err
+	}
+	reportedAt := time.Now()
+	if timestamp != nil {
+		reportedAt = *timestamp
+	}
+	return r.geofenceEventService.ReportPosition(ctx, deviceID, lat, lon, reportedAt)
+}
+
+// InvalidateCacheLevel is the resolver for the invalidateCacheLevel field.
+func (r *mutationResolver) InvalidateCacheLevel(ctx context.Context, level int32, dataset *string) ([]string, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return r.cacheService.InvalidateLevel(withDataset(ctx, dataset), level)
+}
+
+// InvalidateCacheCode is the resolver for the invalidateCacheCode field.
+func (r *mutationResolver) InvalidateCacheCode(ctx context.Context, code string, level int32, dataset *string) ([]string, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return r.cacheService.InvalidateCode(withDataset(ctx, dataset), code, level)
+}
+
+// InvalidateCachePrefix is the resolver for the invalidateCachePrefix field.
+func (r *mutationResolver) InvalidateCachePrefix(ctx context.Context, prefix string) ([]string, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return r.cacheService.InvalidatePrefix(ctx, prefix)
+}
+
+// RenewCacheNamespace is the resolver for the renewCacheNamespace field.
+func (r *mutationResolver) RenewCacheNamespace(ctx context.Context, dataset *string) (int32, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return 0, err
+	}
+	version, err := r.cacheService.RenewNamespace(withDataset(ctx, dataset))
+	return int32(version), err
+}
+
 // Geometry is the resolver for the geometry field.
 func (r *oSMLineResolver) Geometry(ctx context.Context, obj *domain.OSMLine) (map[string]any, error) {
 	var geom map[string]any
@@ -33,17 +200,19 @@ func (r *oSMLineResolver) Geometry(ctx context.Context, obj *domain.OSMLine) (ma
 }
 
 // AdminAreas is the resolver for the adminAreas field.
-func (r *queryResolver) AdminAreas(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	validTolerance, err := validateTolerance(tolerance)
+func (r *queryResolver) AdminAreas(ctx context.Context, adminLevel int32, tolerance *float64, zoom *int32, simplification *domain.Simplification, dataset *string) ([]*domain.AdminArea, error) {
+	ctx = withDataset(ctx, dataset)
+	opts, err := r.geometryOptions(ctx, tolerance, zoom, simplification)
 	if err != nil {
 		return nil, err
 	}
-	return r.adminAreaService.GetAll(ctx, adminLevel, validTolerance)
+	return r.adminAreaService.GetAll(ctx, adminLevel, opts)
 }
 
 // AdminArea is the resolver for the adminArea field.
-func (r *queryResolver) AdminArea(ctx context.Context, id string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	validTolerance, err := validateTolerance(tolerance)
+func (r *queryResolver) AdminArea(ctx context.Context, id string, adminLevel int32, tolerance *float64, zoom *int32, simplification *domain.Simplification, dataset *string) (*domain.AdminArea, error) {
+	ctx = withDataset(ctx, dataset)
+	opts, err := r.geometryOptions(ctx, tolerance, zoom, simplification)
 	if err != nil {
 		return nil, err
 	}
@@ -51,29 +220,91 @@ func (r *queryResolver) AdminArea(ctx context.Context, id string, adminLevel int
 	if err != nil {
 		return nil, err
 	}
-	return r.adminAreaService.GetByID(ctx, id_int, adminLevel, validTolerance)
+	return r.adminAreaService.GetByID(ctx, id_int, adminLevel, opts)
 }
 
 // AdminAreaByCode is the resolver for the adminAreaByCode field.
-func (r *queryResolver) AdminAreaByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	validTolerance, err := validateTolerance(tolerance)
+func (r *queryResolver) AdminAreaByCode(ctx context.Context, code *string, address *model.AdminAddressInput, adminLevel int32, tolerance *float64, zoom *int32, simplification *domain.Simplification, dataset *string) (*domain.AdminArea, error) {
+	ctx = withDataset(ctx, dataset)
+	opts, err := r.geometryOptions(ctx, tolerance, zoom, simplification)
+	if err != nil {
+		return nil, err
+	}
+	areaCode, err := resolveAreaCode(code, address, adminLevel)
 	if err != nil {
 		return nil, err
 	}
-	return r.adminAreaService.GetByCode(ctx, code, adminLevel, validTolerance)
+	return r.adminAreaService.GetByCode(ctx, areaCode, adminLevel, opts)
 }
 
 // ChildrenByCode is the resolver for the childrenByCode field.
-func (r *queryResolver) ChildrenByCode(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	validTolerance, err := validateTolerance(tolerance)
+func (r *queryResolver) ChildrenByCode(ctx context.Context, parentCode string, childLevel int32, tolerance *float64, zoom *int32, simplification *domain.Simplification, dataset *string) ([]*domain.AdminArea, error) {
+	ctx = withDataset(ctx, dataset)
+	opts, err := r.geometryOptions(ctx, tolerance, zoom, simplification)
 	if err != nil {
 		return nil, err
 	}
-	return r.adminAreaService.GetChildren(ctx, parentCode, childLevel, validTolerance)
+	return r.adminAreaService.GetChildren(ctx, parentCode, childLevel, opts)
+}
+
+// Neighbors is the resolver for the neighbors field.
+func (r *queryResolver) Neighbors(ctx context.Context, code string, level int32, tolerance *float64, zoom *int32, simplification *domain.Simplification, dataset *string) ([]*domain.AdminAreaNeighbor, error) {
+	ctx = withDataset(ctx, dataset)
+	opts, err := r.neighborOptions(ctx, tolerance, zoom, simplification)
+	if err != nil {
+		return nil, err
+	}
+	if code == "" {
+		return nil, errors.New("code cannot be empty")
+	}
+	area, err := r.adminAreaService.GetByCode(ctx, code, level, domain.GeometryOptions{Omit: true})
+	if err != nil {
+		return nil, err
+	}
+	return r.adminAreaService.GetNeighbors(ctx, area.ID, level, opts)
+}
+
+// Crosswalk is the resolver for the crosswalk field.
+func (r *queryResolver) Crosswalk(ctx context.Context, code string, level int32, from string, to *string) ([]*domain.CrosswalkMatch, error) {
+	opts := domain.GeometryOptions{Omit: !fieldRequested(ctx, "area", "geometry")}
+	if code == "" {
+		return nil, errors.New("code cannot be empty")
+	}
+	target := ""
+	if to != nil {
+		target = *to
+	}
+	return r.adminAreaService.Crosswalk(ctx, code, level, from, target, opts)
+}
+
+// Topology is the resolver for the topology field.
+func (r *queryResolver) Topology(ctx context.Context, adminLevel int32, parentCode *string, quantization *int32, tolerance *float64, zoom *int32, simplification *domain.Simplification, dataset *string) (map[string]any, error) {
+	ctx = withDataset(ctx, dataset)
+	opts, err := r.simplificationOptions(tolerance, zoom, simplification)
+	if err != nil {
+		return nil, err
+	}
+	validQuantization, err := validateQuantization(quantization)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := domain.ExportScope{AdminLevel: adminLevel, ParentCode: parentCode, Geometry: opts}
+	data, err := r.exportService.TopoJSON(ctx, scope, validQuantization)
+	if err != nil {
+		return nil, err
+	}
+
+	var topology map[string]any
+	if err := json.Unmarshal(data, &topology); err != nil {
+		return nil, err
+	}
+	return topology, nil
 }
 
 // FilterCoordinatesByBoundary is the resolver for the filterCoordinatesByBoundary field.
-func (r *queryResolver) FilterCoordinatesByBoundary(ctx context.Context, coordinates []*model.CoordinateInput, boundaryID string) ([]*domain.Coordinate, error) {
+func (r *queryResolver) FilterCoordinatesByBoundary(ctx context.Context, coordinates []*model.CoordinateInput, boundaryID string, dataset *string) ([]*domain.Coordinate, error) {
+	ctx = withDataset(ctx, dataset)
 	// Parse and validate boundary ID
 	boundaryInfo, err := parseBoundaryID(boundaryID)
 	if err != nil {
@@ -109,6 +340,141 @@ func (r *queryResolver) FilterCoordinatesByBoundary(ctx context.Context, coordin
 	return result, nil
 }
 
+// FilterCoordinatesByGeometry is the resolver for the filterCoordinatesByGeometry field.
+func (r *queryResolver) FilterCoordinatesByGeometry(ctx context.Context, coordinates []*model.CoordinateInput, geometry map[string]any) ([]*domain.Coordinate, error) {
+	// Validate the input polygon and coordinates
+	geoJSON, err := validateGeometryInput(geometry)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCoordinates(coordinates); err != nil {
+		return nil, err
+	}
+
+	// Convert GraphQL model to domain model
+	domainCoords := make([]*domain.Coordinate, len(coordinates))
+	for i, coord := range coordinates {
+		domainCoords[i] = &domain.Coordinate{
+			ID:  coord.ID,
+			Lat: coord.Lat,
+			Lon: coord.Lon,
+		}
+	}
+
+	return r.adminAreaService.FilterCoordinatesByGeometry(ctx, domainCoords, geoJSON)
+}
+
+// ClipByBoundaries is the resolver for the clipByBoundaries field.
+func (r *queryResolver) ClipByBoundaries(ctx context.Context, geometry map[string]any, level int32, dataset *string) ([]*domain.ClippedArea, error) {
+	ctx = withDataset(ctx, dataset)
+	geoJSON, err := validateClipInput(geometry)
+	if err != nil {
+		return nil, err
+	}
+	return r.adminAreaService.ClipByBoundaries(ctx, geoJSON, level)
+}
+
+// AreaCells is the resolver for the areaCells field.
+func (r *queryResolver) AreaCells(ctx context.Context, code string, level int32, resolution int32, grid *domain.CellGrid, dataset *string) ([]string, error) {
+	ctx = withDataset(ctx, dataset)
+	cellGrid := resolveCellGrid(grid)
+	validResolution, err := validateCellResolution(cellGrid, resolution)
+	if err != nil {
+		return nil, err
+	}
+	if code == "" {
+		return nil, errors.New("code cannot be empty")
+	}
+	return r.cellService.Polyfill(ctx, cellGrid, code, level, validResolution)
+}
+
+// CellAreas is the resolver for the cellAreas field.
+func (r *queryResolver) CellAreas(ctx context.Context, cell string, level int32, grid *domain.CellGrid, dataset *string) ([]*domain.CellCoverage, error) {
+	ctx = withDataset(ctx, dataset)
+	return r.cellService.CellAreas(ctx, resolveCellGrid(grid), cell, level)
+}
+
+// AggregateCoordinates is the resolver for the aggregateCoordinates field.
+func (r *queryResolver) AggregateCoordinates(ctx context.Context, coordinates []*model.CoordinateInput, level int32, parentCode *string, dataset *string) ([]*domain.AreaAggregate, error) {
+	ctx = withDataset(ctx, dataset)
+	if err := validateCoordinates(coordinates); err != nil {
+		return nil, err
+	}
+	if err := validateParentCode(parentCode, level); err != nil {
+		return nil, err
+	}
+
+	// Convert GraphQL model to domain model, keeping the weights
+	domainCoords := make([]*domain.Coordinate, len(coordinates))
+	for i, coord := range coordinates {
+		domainCoords[i] = &domain.Coordinate{
+			ID:    coord.ID,
+			Lat:   coord.Lat,
+			Lon:   coord.Lon,
+			Value: coord.Value,
+		}
+	}
+
+	return r.adminAreaService.AggregateCoordinates(ctx, domainCoords, level, parentCode)
+}
+
+// Geofence is the resolver for the geofence field.
+func (r *queryResolver) Geofence(ctx context.Context, id string, tolerance *float64) (*domain.Geofence, error) {
+	opts, err := r.geofenceOptions(ctx, tolerance, "geometry")
+	if err != nil {
+		return nil, err
+	}
+	id_int, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, err
+	}
+	return r.geofenceService.GetByID(ctx, id_int, opts)
+}
+
+// Geofences is the resolver for the geofences field.
+func (r *queryResolver) Geofences(ctx context.Context, tolerance *float64) ([]*domain.Geofence, error) {
+	opts, err := r.geofenceOptions(ctx, tolerance, "geometry")
+	if err != nil {
+		return nil, err
+	}
+	return r.geofenceService.List(ctx, opts)
+}
+
+// GeofencesAt is the resolver for the geofencesAt field.
+func (r *queryResolver) GeofencesAt(ctx context.Context, lat float64, lon float64) ([]*domain.Geofence, error) {
+	if err := validateLatLon(lat, lon, "point"); err != nil {
+		return nil, err
+	}
+	opts, err := r.geofenceOptions(ctx, nil, "geometry")
+	if err != nil {
+		return nil, err
+	}
+	return r.geofenceService.At(ctx, lat, lon, opts)
+}
+
+// MatchGeofences is the resolver for the matchGeofences field.
+func (r *queryResolver) MatchGeofences(ctx context.Context, coordinates []*model.CoordinateInput) ([]*domain.GeofenceMatch, error) {
+	if err := validateCoordinates(coordinates); err != nil {
+		return nil, err
+	}
+	opts, err := r.geofenceOptions(ctx, nil, "geofences", "geometry")
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert GraphQL model to domain model
+	domainCoords := make([]*domain.Coordinate, len(coordinates))
+	for i, coord := range coordinates {
+		domainCoords[i] = &domain.Coordinate{
+			ID:  coord.ID,
+			Lat: coord.Lat,
+			Lon: coord.Lon,
+		}
+	}
+
+	return r.geofenceService.Match(ctx, domainCoords, opts)
+}
+
 // SearchRoadName is the resolver for the searchRoadName field.
 func (r *queryResolver) SearchRoadName(ctx context.Context, searchTerm string, limit *int32) ([]*domain.OSMLine, error) {
 	limitVal := 20
@@ -139,15 +505,43 @@ func (r *queryResolver) NearbyRoads(ctx context.Context, lat float64, lon float6
 	return r.osmLineService.FindNearbyRoads(ctx, lat, lon, radius, limitVal)
 }
 
+// GeofenceEvents is the resolver for the geofenceEvents field.
+func (r *subscriptionResolver) GeofenceEvents(ctx context.Context, deviceIds []string, boundaryIds []string) (<-chan *domain.GeofenceEvent, error) {
+	geofenceIDs, err := parseIDs(boundaryIds)
+	if err != nil {
+		return nil, err
+	}
+	return r.geofenceEventService.Subscribe(ctx, domain.GeofenceEventFilter{
+		DeviceIDs:   deviceIds,
+		GeofenceIDs: geofenceIDs,
+	})
+}
+
 // AdminArea returns AdminAreaResolver implementation.
 func (r *Resolver) AdminArea() AdminAreaResolver { return &adminAreaResolver{r} }
 
+// ClippedArea returns ClippedAreaResolver implementation.
+func (r *Resolver) ClippedArea() ClippedAreaResolver { return &clippedAreaResolver{r} }
+
+// Geofence returns GeofenceResolver implementation.
+func (r *Resolver) Geofence() GeofenceResolver { return &geofenceResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
 // OSMLine returns OSMLineResolver implementation.
 func (r *Resolver) OSMLine() OSMLineResolver { return &oSMLineResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type adminAreaResolver struct{ *Resolver }
+type clippedAreaResolver struct{ *Resolver }
+type geofenceResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
 type oSMLineResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -1,11 +1,16 @@
 package graph
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hoshina-dev/gapi/internal/adapters/graph/model"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/geo"
 )
 
 // validateTolerance ensures tolerance is not negative and returns nil if it's 0 or less
@@ -22,6 +27,83 @@ func validateTolerance(tolerance *float64) (*float64, error) {
 	return tolerance, nil
 }
 
+// resolveTolerance combines the tolerance and zoom arguments into a single tolerance.
+// Zoom maps onto the preset ladder; in strict mode arbitrary tolerances outside it are rejected.
+func resolveTolerance(tolerance *float64, zoom *int32, strict bool) (*float64, error) {
+	validTolerance, err := validateTolerance(tolerance)
+	if err != nil {
+		return nil, err
+	}
+	if zoom != nil {
+		if validTolerance != nil {
+			return nil, errors.New("provide either tolerance or zoom, not both")
+		}
+		if *zoom < 0 {
+			return nil, errors.New("zoom must be non-negative")
+		}
+		return domain.ToleranceForZoom(*zoom), nil
+	}
+	if strict && validTolerance != nil && !slices.Contains(domain.ZoomTolerances, *validTolerance) {
+		return nil, fmt.Errorf("tolerance must be one of %v, or use zoom instead", domain.ZoomTolerances)
+	}
+	return validTolerance, nil
+}
+
+// validateSimplification ensures coverage simplification is only requested for a precomputed tolerance
+func validateSimplification(simplification *domain.Simplification, tolerance *float64) (domain.Simplification, error) {
+	if simplification == nil || *simplification == domain.SimplificationStandard {
+		return domain.SimplificationStandard, nil
+	}
+	if *simplification != domain.SimplificationCoverage {
+		return "", fmt.Errorf("unsupported simplification: %s", *simplification)
+	}
+	if tolerance == nil {
+		return "", errors.New("COVERAGE simplification requires a tolerance")
+	}
+	if !slices.Contains(domain.ZoomTolerances, *tolerance) {
+		return "", fmt.Errorf("COVERAGE simplification only supports tolerances %v", domain.ZoomTolerances)
+	}
+	return domain.SimplificationCoverage, nil
+}
+
+// validateQuantization ensures the TopoJSON quantization is disabled (0) or a usable grid size
+func validateQuantization(quantization *int32) (int, error) {
+	if quantization == nil {
+		return 0, nil
+	}
+	if *quantization < 0 || *quantization == 1 {
+		return 0, errors.New("quantization must be 0 (disabled) or at least 2")
+	}
+	return int(*quantization), nil
+}
+
+// resolveAreaCode picks the GID to look up from either an explicit code or an
+// address previously returned by the API. Exactly one of them must be provided.
+func resolveAreaCode(code *string, address *model.AdminAddressInput, adminLevel int32) (string, error) {
+	if code != nil && address != nil {
+		return "", errors.New("provide either code or address, not both")
+	}
+	if code != nil {
+		if *code == "" {
+			return "", errors.New("code cannot be empty")
+		}
+		return *code, nil
+	}
+	if address == nil {
+		return "", errors.New("either code or address must be provided")
+	}
+
+	gids := []*string{address.Gid0, address.Gid1, address.Gid2, address.Gid3, address.Gid4}
+	if adminLevel < 0 || int(adminLevel) >= len(gids) {
+		return "", errors.New("invalid admin level")
+	}
+	gid := gids[adminLevel]
+	if gid == nil || *gid == "" {
+		return "", fmt.Errorf("address has no gid%d for admin level %d", adminLevel, adminLevel)
+	}
+	return *gid, nil
+}
+
 // BoundaryInfo contains parsed boundary ID information
 type BoundaryInfo struct {
 	FullID     string
@@ -56,6 +138,43 @@ func parseBoundaryID(boundaryID string) (*BoundaryInfo, error) {
 	}, nil
 }
 
+// validateParentCode ensures an optional parent boundary ID is well formed and above adminLevel
+func validateParentCode(parentCode *string, adminLevel int32) error {
+	if parentCode == nil {
+		return nil
+	}
+	parent, err := parseBoundaryID(*parentCode)
+	if err != nil {
+		return fmt.Errorf("invalid parentCode: %w", err)
+	}
+	if parent.AdminLevel >= adminLevel {
+		return fmt.Errorf("parentCode %s must be above admin level %d", *parentCode, adminLevel)
+	}
+	return nil
+}
+
+// resolveCellGrid defaults the grid to geohash
+func resolveCellGrid(grid *domain.CellGrid) domain.CellGrid {
+	if grid == nil {
+		return domain.CellGridGeohash
+	}
+	return *grid
+}
+
+// validateCellResolution ensures the resolution exists in the grid
+func validateCellResolution(grid domain.CellGrid, resolution int32) (int, error) {
+	if grid == domain.CellGridGeohash && (resolution < 1 || resolution > geo.MaxGeohashPrecision) {
+		return 0, fmt.Errorf("geohash resolution must be between 1 and %d", geo.MaxGeohashPrecision)
+	}
+	if grid == domain.CellGridH3 && (resolution < 0 || resolution > geo.MaxH3Resolution) {
+		return 0, fmt.Errorf("H3 resolution must be between 0 and %d", geo.MaxH3Resolution)
+	}
+	return int(resolution), nil
+}
+
+// maxGeometryVertices caps the size of GeoJSON polygons accepted as filter input
+const maxGeometryVertices = 50000
+
 // validateCoordinates ensures coordinates array is within limits and has valid values
 func validateCoordinates(coordinates []*model.CoordinateInput) error {
 	if len(coordinates) == 0 {
@@ -70,13 +189,148 @@ func validateCoordinates(coordinates []*model.CoordinateInput) error {
 		if coord == nil {
 			return fmt.Errorf("coordinate at index %d cannot be nil", i)
 		}
-		if coord.Lat < -90 || coord.Lat > 90 {
-			return fmt.Errorf("invalid latitude at index %d: must be between -90 and 90", i)
-		}
-		if coord.Lon < -180 || coord.Lon > 180 {
-			return fmt.Errorf("invalid longitude at index %d: must be between -180 and 180", i)
+		if err := validateLatLon(coord.Lat, coord.Lon, fmt.Sprintf("index %d", i)); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
+
+// validateLatLon ensures a position is within WGS84 bounds; position describes it in errors
+func validateLatLon(lat, lon float64, position string) error {
+	if lat < -90 || lat > 90 {
+		return fmt.Errorf("invalid latitude at %s: must be between -90 and 90", position)
+	}
+	if lon < -180 || lon > 180 {
+		return fmt.Errorf("invalid longitude at %s: must be between -180 and 180", position)
+	}
+	return nil
+}
+
+// validateGeometryInput ensures a GeoJSON input is a Polygon or MultiPolygon within the
+// vertex cap and with valid positions, and returns it encoded for the repository.
+// Topological validity is checked by PostGIS.
+func validateGeometryInput(geometry map[string]any) ([]byte, error) {
+	if len(geometry) == 0 {
+		return nil, errors.New("geometry cannot be empty")
+	}
+
+	data, err := json.Marshal(geometry)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+	polygons, err := geo.ParseMultiPolygon(data)
+	if err != nil {
+		return nil, fmt.Errorf("geometry must be a GeoJSON Polygon or MultiPolygon: %w", err)
+	}
+
+	vertices := 0
+	for i, polygon := range polygons {
+		if len(polygon) == 0 {
+			return nil, fmt.Errorf("polygon %d has no rings", i)
+		}
+		for j, ring := range polygon {
+			if len(ring) < 4 {
+				return nil, fmt.Errorf("ring %d of polygon %d must have at least 4 positions", j, i)
+			}
+			vertices += len(ring)
+			if vertices > maxGeometryVertices {
+				return nil, fmt.Errorf("geometry cannot exceed %d vertices", maxGeometryVertices)
+			}
+			for k, p := range ring {
+				if err := validateLatLon(p[1], p[0], fmt.Sprintf("polygon %d ring %d position %d", i, j, k)); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// validateClipInput ensures a geometry to clip is a LineString, Polygon or their multi
+// counterparts within the vertex cap, and returns it encoded for the repository
+func validateClipInput(geometry map[string]any) ([]byte, error) {
+	if len(geometry) == 0 {
+		return nil, errors.New("geometry cannot be empty")
+	}
+
+	data, err := json.Marshal(geometry)
+	if err != nil {
+		return nil, fmt.Errorf("invalid geometry: %w", err)
+	}
+	parsed, err := geo.ParseGeometry(data)
+	if err != nil {
+		return nil, fmt.Errorf("geometry must be a GeoJSON LineString, Polygon or their multi types: %w", err)
+	}
+
+	switch parsed.Type {
+	case geo.GeometryPolygon, geo.GeometryMultiPolygon:
+		return validateGeometryInput(geometry)
+	case geo.GeometryLineString, geo.GeometryMultiLineString:
+	default:
+		return nil, fmt.Errorf("geometry must be a GeoJSON LineString, Polygon or their multi types, got %s", parsed.Type)
+	}
+
+	vertices := 0
+	for i, line := range parsed.Lines {
+		if len(line) < 2 {
+			return nil, fmt.Errorf("line %d must have at least 2 positions", i)
+		}
+		vertices += len(line)
+		if vertices > maxGeometryVertices {
+			return nil, fmt.Errorf("geometry cannot exceed %d vertices", maxGeometryVertices)
+		}
+		for j, p := range line {
+			if err := validateLatLon(p[1], p[0], fmt.Sprintf("line %d position %d", i, j)); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data, nil
+}
+
+// validateGeofenceInput checks the geometry or boundary codes of a geofence and converts
+// it to the domain definition; whether exactly one is given is checked by the service.
+func validateGeofenceInput(input model.GeofenceInput) (domain.GeofenceDefinition, error) {
+	definition := domain.GeofenceDefinition{
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	if input.Geometry != nil {
+		geoJSON, err := validateGeometryInput(input.Geometry)
+		if err != nil {
+			return definition, err
+		}
+		definition.Geometry = geoJSON
+	}
+	for _, code := range input.BoundaryCodes {
+		if _, err := parseBoundaryID(code); err != nil {
+			return definition, fmt.Errorf("invalid boundary code %q: %w", code, err)
+		}
+	}
+	definition.BoundaryCodes = input.BoundaryCodes
+	return definition, nil
+}
+
+// validatePositionReport ensures a reported device position has a device and valid coordinates
+func validatePositionReport(deviceID string, lat, lon float64) error {
+	if strings.TrimSpace(deviceID) == "" {
+		return errors.New("deviceId cannot be empty")
+	}
+	return validateLatLon(lat, lon, "reported position")
+}
+
+// parseIDs converts GraphQL IDs to the integer IDs used by the repositories
+func parseIDs(ids []string) ([]int, error) {
+	result := make([]int, len(ids))
+	for i, id := range ids {
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			return nil, fmt.Errorf("invalid id %q: must be an integer", id)
+		}
+		result[i] = n
+	}
+	return result, nil
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hoshina-dev/gapi/internal/adapters/graph"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// adminAuthorized reports whether an Authorization header carries the admin token.
+// An empty token disables the admin API.
+func adminAuthorized(adminToken, authorization string) bool {
+	if adminToken == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(authorization), []byte("Bearer "+adminToken)) == 1
+}
+
+// adminAuth rejects requests to the admin endpoints without the admin token
+func adminAuth(adminToken string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if adminToken == "" {
+			return fiber.NewError(fiber.StatusForbidden, "admin API disabled, set ADMIN_TOKEN")
+		}
+		if !adminAuthorized(adminToken, c.Get(fiber.HeaderAuthorization)) {
+			c.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+			return fiber.NewError(fiber.StatusUnauthorized, "admin token required")
+		}
+		return c.Next()
+	}
+}
+
+// withAdminContext marks GraphQL requests carrying the admin token, so admin mutations resolve
+func withAdminContext(next http.Handler, adminToken string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if adminAuthorized(adminToken, r.Header.Get("Authorization")) {
+			r = r.WithContext(graph.WithAdmin(r.Context()))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// invalidateCacheLevelHandler drops the cached results of an admin level.
+// Query parameters: dataset.
+func invalidateCacheLevelHandler(cacheService ports.CacheService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		level, err := parseAdminLevel(c.Params("level"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		patterns, err := cacheService.InvalidateLevel(domain.WithDataset(c.UserContext(), c.Query("dataset")), level)
+		if err != nil {
+			return err
+		}
+		return c.JSON(fiber.Map{"patterns": patterns})
+	}
+}
+
+// invalidateCacheCodeHandler drops the cached results involving one area.
+// Query parameters: dataset.
+func invalidateCacheCodeHandler(cacheService ports.CacheService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		level, err := parseAdminLevel(c.Params("level"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		patterns, err := cacheService.InvalidateCode(domain.WithDataset(c.UserContext(), c.Query("dataset")), c.Params("code"), level)
+		if err != nil {
+			return err
+		}
+		return c.JSON(fiber.Map{"patterns": patterns})
+	}
+}
+
+// invalidateCachePrefixHandler drops the cached keys starting with a prefix
+func invalidateCachePrefixHandler(cacheService ports.CacheService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		patterns, err := cacheService.InvalidatePrefix(c.UserContext(), c.Params("prefix"))
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+		return c.JSON(fiber.Map{"patterns": patterns})
+	}
+}
+
+// renewCacheNamespaceHandler moves the cached results of a dataset to a new namespace.
+// Query parameters: dataset.
+func renewCacheNamespaceHandler(cacheService ports.CacheService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		version, err := cacheService.RenewNamespace(domain.WithDataset(c.UserContext(), c.Query("dataset")))
+		if err != nil {
+			return err
+		}
+		return c.JSON(fiber.Map{"version": version})
+	}
+}
+
+func parseAdminLevel(s string) (int32, error) {
+	level, err := strconv.ParseInt(s, 10, 32)
+	if err != nil || level < 0 || level > 4 {
+		return 0, errors.New("level must be an integer between 0 and 4")
+	}
+	return int32(level), nil
+}
@@ -0,0 +1,150 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hoshina-dev/gapi/internal/adapters/graph"
+	"github.com/hoshina-dev/gapi/internal/adapters/graph/mocks"
+	"github.com/hoshina-dev/gapi/internal/adapters/http"
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testAdminToken = "s3cret"
+
+func setupTestAppWithCache(adminToken string) (*fiber.App, *mocks.MockCacheService) {
+	cfg := infrastructure.LoadConfig()
+	cfg.AdminToken = adminToken
+	mockCacheService := new(mocks.MockCacheService)
+	mockExportService := new(mocks.MockExportService)
+	resolver := graph.NewResolver(new(mocks.MockAdminAreaService), new(mocks.MockOSMLineService), mockExportService, new(mocks.MockGeofenceService), new(mocks.MockGeofenceEventService), new(mocks.MockCellService), mockCacheService, cfg)
+	app := http.SetupRouter(resolver, mockExportService, mockCacheService, cfg)
+	return app, mockCacheService
+}
+
+func inDataset(name string) any {
+	return mock.MatchedBy(func(ctx context.Context) bool { return domain.DatasetFromContext(ctx) == name })
+}
+
+func TestAdminCache_InvalidateLevel(t *testing.T) {
+	// Arrange
+	app, mockCacheService := setupTestAppWithCache(testAdminToken)
+	mockCacheService.On("InvalidateLevel", inDataset("gadm41"), int32(1)).Return([]string{"admin_area:list@gadm41:1:*"}, nil)
+
+	req := httptest.NewRequest("DELETE", "/admin/cache/levels/1?dataset=gadm41", nil)
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{"patterns": ["admin_area:list@gadm41:1:*"]}`, string(body))
+	mockCacheService.AssertExpectations(t)
+}
+
+func TestAdminCache_InvalidateCode(t *testing.T) {
+	// Arrange
+	app, mockCacheService := setupTestAppWithCache(testAdminToken)
+	mockCacheService.On("InvalidateCode", inDataset(""), "THA.10_1", int32(1)).Return([]string{"admin_area:code:1:THA.10_1:*"}, nil)
+
+	req := httptest.NewRequest("DELETE", "/admin/cache/levels/1/codes/THA.10_1", nil)
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	mockCacheService.AssertExpectations(t)
+}
+
+func TestAdminCache_RenewNamespace(t *testing.T) {
+	// Arrange
+	app, mockCacheService := setupTestAppWithCache(testAdminToken)
+	mockCacheService.On("RenewNamespace", inDataset("gadm36")).Return(int64(4), nil)
+
+	req := httptest.NewRequest("POST", "/admin/cache/namespace?dataset=gadm36", nil)
+	req.Header.Set("Authorization", "Bearer "+testAdminToken)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	assert.JSONEq(t, `{"version": 4}`, string(body))
+}
+
+func TestAdminCache_RejectsRequests(t *testing.T) {
+	tests := []struct {
+		name          string
+		adminToken    string
+		authorization string
+		path          string
+		status        int
+	}{
+		{name: "no token", adminToken: testAdminToken, path: "/admin/cache/levels/1", status: fiber.StatusUnauthorized},
+		{name: "wrong token", adminToken: testAdminToken, authorization: "Bearer wrong", path: "/admin/cache/levels/1", status: fiber.StatusUnauthorized},
+		{name: "admin API disabled", authorization: "Bearer ", path: "/admin/cache/levels/1", status: fiber.StatusForbidden},
+		{name: "invalid level", adminToken: testAdminToken, authorization: "Bearer " + testAdminToken, path: "/admin/cache/levels/9", status: fiber.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, mockCacheService := setupTestAppWithCache(tt.adminToken)
+			req := httptest.NewRequest("DELETE", tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			resp, err := app.Test(req, -1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			mockCacheService.AssertNotCalled(t, "InvalidateLevel", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGraphQLEndpoint_InvalidateCacheRequiresAdminToken(t *testing.T) {
+	// Arrange
+	app, mockCacheService := setupTestAppWithCache(testAdminToken)
+	mockCacheService.On("InvalidatePrefix", mock.Anything, "admin_area:list").Return([]string{"admin_area:list*"}, nil)
+
+	query := `{"query": "mutation { invalidateCachePrefix(prefix: \"admin_area:list\") }"}`
+	post := func(authorization string) map[string]any {
+		req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+		req.Header.Set("Content-Type", "application/json")
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		resp, err := app.Test(req, -1)
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		var result map[string]any
+		json.Unmarshal(body, &result)
+		return result
+	}
+
+	// Act
+	anonymous := post("")
+	admin := post("Bearer " + testAdminToken)
+
+	// Assert
+	assert.NotNil(t, anonymous["errors"])
+	assert.Nil(t, admin["errors"])
+	assert.Equal(t, []any{"admin_area:list*"}, admin["data"].(map[string]any)["invalidateCachePrefix"])
+	mockCacheService.AssertNumberOfCalls(t, "InvalidatePrefix", 1)
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/gzip"
+)
+
+// negotiateEncoding picks the response encoding from an Accept-Encoding header,
+// preferring brotli over gzip and returning "" when neither is accepted.
+func negotiateEncoding(acceptEncoding string) string {
+	accepted := map[string]bool{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		params = strings.ReplaceAll(params, " ", "")
+		if params == "q=0" || params == "q=0.0" || params == "q=0.00" || params == "q=0.000" {
+			continue
+		}
+		accepted[strings.ToLower(name)] = true
+	}
+
+	switch {
+	case accepted["br"]:
+		return "br"
+	case accepted["gzip"]:
+		return "gzip"
+	default:
+		return ""
+	}
+}
+
+// compressWriter wraps w with the given encoding. The returned writer must be closed
+// to flush the compressed trailer; closing it does not close w.
+func compressWriter(w io.Writer, encoding string) io.WriteCloser {
+	switch encoding {
+	case "br":
+		return brotli.NewWriterLevel(w, brotli.DefaultCompression)
+	case "gzip":
+		return gzip.NewWriter(w)
+	default:
+		return nopWriteCloser{w}
+	}
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
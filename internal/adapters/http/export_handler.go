@@ -0,0 +1,259 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// topoJSONHandler serves a TopoJSON topology of an admin level or of a parent's children.
+// Query parameters: level (required), parent, quantization (default 10000), tolerance, zoom, simplification, dataset.
+func topoJSONHandler(exportService ports.ExportService, strictTolerance bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		scope, err := parseExportScope(c, c.Query("level"), strictTolerance)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		quantization, err := strconv.Atoi(c.Query("quantization", "10000"))
+		if err != nil || quantization < 0 || quantization == 1 {
+			return fiber.NewError(fiber.StatusBadRequest, "quantization must be 0 (disabled) or at least 2")
+		}
+
+		data, err := exportService.TopoJSON(domain.WithDataset(c.UserContext(), c.Query("dataset")), scope, quantization)
+		if err != nil {
+			return err
+		}
+
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		return c.Send(data)
+	}
+}
+
+// exportFormat is a file format served by the export endpoints.
+// Archives are not compressed again and may use a longer file extension than the route's.
+type exportFormat struct {
+	Format        domain.ExportFormat
+	ContentType   string
+	FileExtension string
+	Archive       bool
+}
+
+// exportFormats maps export file extensions to their format and content type
+var exportFormats = map[string]exportFormat{
+	"geojson":  {Format: domain.ExportFormatGeoJSON, ContentType: "application/geo+json"},
+	"geojsonl": {Format: domain.ExportFormatGeoJSONSeq, ContentType: "application/geo+json-seq"},
+	"ndjson":   {Format: domain.ExportFormatGeoJSONSeq, ContentType: "application/x-ndjson"},
+	"fgb":      {Format: domain.ExportFormatFlatGeobuf, ContentType: "application/flatgeobuf"},
+	"gpkg":     {Format: domain.ExportFormatGeoPackage, ContentType: "application/geopackage+sqlite3"},
+	"shp":      {Format: domain.ExportFormatShapefile, ContentType: "application/zip", FileExtension: "shp.zip", Archive: true},
+	"kml":      {Format: domain.ExportFormatKML, ContentType: "application/vnd.google-earth.kml+xml"},
+}
+
+// negotiableExtensions lists the formats offered to the Accept header, most preferred first
+var negotiableExtensions = []string{"geojson", "geojsonl", "ndjson", "fgb", "gpkg", "shp", "kml"}
+
+// negotiateFormat picks the format from the file extension when one is given,
+// otherwise from the Accept header, defaulting to GeoJSON
+func negotiateFormat(c *fiber.Ctx, ext string) (string, exportFormat, error) {
+	if ext != "" {
+		format, ok := exportFormats[ext]
+		if !ok {
+			return "", exportFormat{}, fiber.NewError(fiber.StatusNotFound, "unsupported export format: "+ext)
+		}
+		return ext, format, nil
+	}
+
+	offers := make([]string, len(negotiableExtensions))
+	for i, ext := range negotiableExtensions {
+		offers[i] = exportFormats[ext].ContentType
+	}
+	accepted := c.Accepts(offers...)
+	for _, ext := range negotiableExtensions {
+		if exportFormats[ext].ContentType == accepted {
+			return ext, exportFormats[ext], nil
+		}
+	}
+	return "", exportFormat{}, fiber.NewError(fiber.StatusNotAcceptable, "supported formats: "+strings.Join(offers, ", "))
+}
+
+// adminExportHandler exports an admin level as a GeoJSON FeatureCollection (/export/admin/2.geojson),
+// newline-delimited features (.geojsonl, .ndjson), FlatGeobuf (.fgb), GeoPackage (.gpkg),
+// a zipped Shapefile (.shp) or KML (.kml).
+// Without an extension (/export/admin/2) the format is negotiated from the Accept header.
+// Query parameters: parent, tolerance, zoom, simplification.
+func adminExportHandler(exportService ports.ExportService, strictTolerance bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ext, format, err := negotiateFormat(c, c.Params("ext"))
+		if err != nil {
+			return err
+		}
+
+		scope, err := parseExportScope(c, c.Params("level"), strictTolerance)
+		if err != nil {
+			return fiber.NewError(fiber.StatusBadRequest, err.Error())
+		}
+
+		// The status is committed once streaming starts, so the parent is checked first
+		if scope.ParentCode != nil {
+			scope, err = exportService.ResolveScope(c.UserContext(), scope)
+			if errors.Is(err, domain.ErrBoundaryNotFound) {
+				return fiber.NewError(fiber.StatusNotFound, err.Error())
+			} else if err != nil {
+				return err
+			}
+		}
+
+		filename := fmt.Sprintf("admin%d.%s", scope.AdminLevel, format.fileExtension(ext))
+		return streamExport(c, format, filename, func(ctx context.Context, w io.Writer) error {
+			return exportService.Write(ctx, scope, format.Format, w)
+		})
+	}
+}
+
+// roadExportHandler exports road name search results in the same formats as admin levels,
+// by extension (/export/roads.fgb) or negotiated from the Accept header (/export/roads).
+// Query parameters: q (required), limit (default 20).
+func roadExportHandler(exportService ports.ExportService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ext, format, err := negotiateFormat(c, c.Params("ext"))
+		if err != nil {
+			return err
+		}
+
+		searchTerm := c.Query("q")
+		if searchTerm == "" {
+			return fiber.NewError(fiber.StatusBadRequest, "q is required")
+		}
+		limit, err := strconv.Atoi(c.Query("limit", "20"))
+		if err != nil || limit <= 0 {
+			return fiber.NewError(fiber.StatusBadRequest, "limit must be a positive integer")
+		}
+
+		return streamExport(c, format, "roads."+format.fileExtension(ext), func(ctx context.Context, w io.Writer) error {
+			return exportService.WriteRoads(ctx, searchTerm, limit, format.Format, w)
+		})
+	}
+}
+
+func (f exportFormat) fileExtension(ext string) string {
+	if f.FileExtension != "" {
+		return f.FileExtension
+	}
+	return ext
+}
+
+// streamExport sends the export as an attachment, compressed with brotli or gzip when accepted.
+// write runs on the request context after the handler has returned, and the context is
+// cancelled as soon as writing to the client fails, so a disconnect ends the query.
+func streamExport(c *fiber.Ctx, format exportFormat, filename string, write func(ctx context.Context, w io.Writer) error) error {
+	encoding := ""
+	if !format.Archive {
+		encoding = negotiateEncoding(c.Get(fiber.HeaderAcceptEncoding))
+	}
+	c.Set(fiber.HeaderContentType, format.ContentType)
+	c.Set(fiber.HeaderVary, fiber.HeaderAccept+", "+fiber.HeaderAcceptEncoding)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	if encoding != "" {
+		c.Set(fiber.HeaderContentEncoding, encoding)
+	}
+
+	// The status line is sent before the first row is read, so failures can only be logged.
+	// The fiber context is released once the handler returns, so its user context is taken now.
+	requestCtx := c.UserContext()
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		ctx, cancel := context.WithCancel(requestCtx)
+		defer cancel()
+		out := compressWriter(&cancelWriter{w: w, cancel: cancel}, encoding)
+		err := write(ctx, out)
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if flushErr := w.Flush(); err == nil {
+			err = flushErr
+		}
+		if err != nil {
+			log.Errorf("Export of %s failed: %v", filename, err)
+		}
+	})
+	return nil
+}
+
+// cancelWriter cancels the export when a write to the client fails
+type cancelWriter struct {
+	w      io.Writer
+	cancel context.CancelFunc
+}
+
+func (c *cancelWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	if err != nil {
+		c.cancel()
+	}
+	return n, err
+}
+
+// parseExportScope reads the admin level, optional parent and simplification settings.
+// In strict mode only tolerances from the zoom ladder are accepted.
+func parseExportScope(c *fiber.Ctx, levelParam string, strictTolerance bool) (domain.ExportScope, error) {
+	level, err := strconv.Atoi(levelParam)
+	if err != nil || level < 0 || level > 4 {
+		return domain.ExportScope{}, errors.New("level must be an integer between 0 and 4")
+	}
+	scope := domain.ExportScope{AdminLevel: int32(level)}
+
+	if parent := c.Query("parent"); parent != "" {
+		if level == 0 {
+			return domain.ExportScope{}, errors.New("parent cannot be used with level 0")
+		}
+		scope.ParentCode = &parent
+	}
+
+	if t := c.Query("tolerance"); t != "" {
+		tolerance, err := strconv.ParseFloat(t, 64)
+		if err != nil || tolerance < 0 {
+			return domain.ExportScope{}, errors.New("tolerance must be a non-negative number")
+		}
+		if strictTolerance && tolerance > 0 && !slices.Contains(domain.ZoomTolerances, tolerance) {
+			return domain.ExportScope{}, fmt.Errorf("tolerance must be one of %v, or use zoom instead", domain.ZoomTolerances)
+		}
+		if tolerance > 0 {
+			scope.Geometry.Tolerance = &tolerance
+		}
+	}
+
+	if z := c.Query("zoom"); z != "" {
+		if scope.Geometry.Tolerance != nil {
+			return domain.ExportScope{}, errors.New("provide either tolerance or zoom, not both")
+		}
+		zoom, err := strconv.Atoi(z)
+		if err != nil || zoom < 0 {
+			return domain.ExportScope{}, errors.New("zoom must be a non-negative integer")
+		}
+		scope.Geometry.Tolerance = domain.ToleranceForZoom(int32(zoom))
+	}
+
+	switch domain.Simplification(strings.ToUpper(c.Query("simplification", string(domain.SimplificationStandard)))) {
+	case domain.SimplificationStandard:
+		scope.Geometry.Simplification = domain.SimplificationStandard
+	case domain.SimplificationCoverage:
+		if scope.Geometry.Tolerance == nil || !slices.Contains(domain.ZoomTolerances, *scope.Geometry.Tolerance) {
+			return domain.ExportScope{}, errors.New("COVERAGE simplification requires a zoom or tolerance from the preset ladder")
+		}
+		scope.Geometry.Simplification = domain.SimplificationCoverage
+	default:
+		return domain.ExportScope{}, errors.New("simplification must be STANDARD or COVERAGE")
+	}
+
+	return scope, nil
+}
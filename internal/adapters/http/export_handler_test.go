@@ -0,0 +1,337 @@
+package http_test
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestTopoJSONExport_ChildrenOfParent(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	topology := []byte(`{"type":"Topology","objects":{"admin2":{"type":"GeometryCollection","geometries":[]}},"arcs":[]}`)
+	mockExport.On("TopoJSON",
+		mock.Anything,
+		mock.MatchedBy(func(scope domain.ExportScope) bool {
+			return scope.AdminLevel == 2 &&
+				scope.ParentCode != nil && *scope.ParentCode == "THA.10_1" &&
+				scope.Geometry.Tolerance != nil && *scope.Geometry.Tolerance == domain.ZoomTolerances[6] &&
+				scope.Geometry.Simplification == domain.SimplificationCoverage
+		}),
+		1000,
+	).Return(topology, nil)
+
+	req := httptest.NewRequest("GET", "/export/topojson?level=2&parent=THA.10_1&zoom=6&simplification=coverage&quantization=1000", nil)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, fiber.MIMEApplicationJSON, resp.Header.Get(fiber.HeaderContentType))
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(topology), string(body))
+	mockExport.AssertExpectations(t)
+}
+
+func TestTopoJSONExport_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"missing level", ""},
+		{"level out of range", "?level=7"},
+		{"parent on level 0", "?level=0&parent=THA"},
+		{"quantization of one", "?level=1&quantization=1"},
+		{"coverage without zoom", "?level=1&simplification=COVERAGE"},
+		{"unknown simplification", "?level=1&simplification=FAST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			app, _, mockExport := setupTestAppWithExport()
+			req := httptest.NewRequest("GET", "/export/topojson"+tt.query, nil)
+
+			// Act
+			resp, err := app.Test(req, -1)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, fiber.StatusBadRequest, resp.StatusCode)
+			mockExport.AssertNotCalled(t, "TopoJSON", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGeoJSONExport_StreamsGzipFeatureCollection(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	collection := `{"type":"FeatureCollection","features":[]}`
+	parent, tolerance := "THA.10.1_1", 0.001
+	mockExport.On("ResolveScope", mock.Anything, mock.Anything).
+		Return(domain.ExportScope{AdminLevel: 3, ParentCode: &parent, Geometry: domain.GeometryOptions{Tolerance: &tolerance}}, nil)
+	mockExport.On("Write",
+		mock.Anything,
+		mock.MatchedBy(func(scope domain.ExportScope) bool {
+			return scope.AdminLevel == 3 && scope.ParentCode != nil && *scope.ParentCode == "THA.10.1_1" &&
+				scope.Geometry.Tolerance != nil && *scope.Geometry.Tolerance == 0.001
+		}),
+		domain.ExportFormatGeoJSON,
+		mock.Anything,
+	).Run(func(args mock.Arguments) {
+		args.Get(3).(io.Writer).Write([]byte(collection))
+	}).Return(nil)
+
+	req := httptest.NewRequest("GET", "/export/admin/3.geojson?parent=THA.10.1_1&tolerance=0.001", nil)
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "gzip", resp.Header.Get(fiber.HeaderContentEncoding))
+	assert.Equal(t, "application/geo+json", resp.Header.Get(fiber.HeaderContentType))
+
+	reader, err := gzip.NewReader(resp.Body)
+	assert.NoError(t, err)
+	body, err := io.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, collection, string(body))
+	mockExport.AssertExpectations(t)
+}
+
+func TestGeoJSONExport_UnknownParent(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("ResolveScope",
+		mock.Anything,
+		mock.MatchedBy(func(scope domain.ExportScope) bool {
+			return scope.ParentCode != nil && *scope.ParentCode == "THA.99_1"
+		}),
+	).Return(domain.ExportScope{}, fmt.Errorf("%w: THA.99_1", domain.ErrBoundaryNotFound))
+
+	req := httptest.NewRequest("GET", "/export/admin/2.geojson?parent=THA.99_1", nil)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	mockExport.AssertNotCalled(t, "Write", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGeoJSONExport_NDJSON(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("Write", mock.Anything, mock.Anything, domain.ExportFormatGeoJSONSeq, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(io.Writer).Write([]byte("{\"type\":\"Feature\"}\n"))
+		}).Return(nil)
+
+	req := httptest.NewRequest("GET", "/export/admin/1.ndjson", nil)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get(fiber.HeaderContentEncoding))
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get(fiber.HeaderContentType))
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "{\"type\":\"Feature\"}\n", string(body))
+}
+
+func TestGeoJSONExport_UnknownFormat(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+	req := httptest.NewRequest("GET", "/export/admin/1.csv", nil)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotFound, resp.StatusCode)
+	mockExport.AssertNotCalled(t, "Write", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAdminExport_NegotiatesFlatGeobuf(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("Write", mock.Anything, mock.Anything, domain.ExportFormatFlatGeobuf, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(io.Writer).Write([]byte("fgb\x03fgb\x00"))
+		}).Return(nil)
+
+	req := httptest.NewRequest("GET", "/export/admin/2", nil)
+	req.Header.Set("Accept", "application/geo+json;q=0.5, application/flatgeobuf")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/flatgeobuf", resp.Header.Get(fiber.HeaderContentType))
+	assert.Contains(t, resp.Header.Get(fiber.HeaderContentDisposition), `filename="admin2.fgb"`)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "fgb\x03fgb\x00", string(body))
+	mockExport.AssertExpectations(t)
+}
+
+func TestAdminExport_GeoPackageExtension(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("Write",
+		mock.Anything,
+		mock.MatchedBy(func(scope domain.ExportScope) bool { return scope.AdminLevel == 1 }),
+		domain.ExportFormatGeoPackage,
+		mock.Anything,
+	).Return(nil)
+
+	// The extension wins over the Accept header
+	req := httptest.NewRequest("GET", "/export/admin/1.gpkg", nil)
+	req.Header.Set("Accept", "application/geo+json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/geopackage+sqlite3", resp.Header.Get(fiber.HeaderContentType))
+	mockExport.AssertExpectations(t)
+}
+
+func TestAdminExport_NotAcceptable(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+	req := httptest.NewRequest("GET", "/export/admin/1", nil)
+	req.Header.Set("Accept", "text/csv")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusNotAcceptable, resp.StatusCode)
+	mockExport.AssertNotCalled(t, "Write", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestRoadExport(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("WriteRoads", mock.Anything, "sukhumvit", 50, domain.ExportFormatGeoPackage, mock.Anything).Return(nil)
+
+	req := httptest.NewRequest("GET", "/export/roads?q=sukhumvit&limit=50", nil)
+	req.Header.Set("Accept", "application/geopackage+sqlite3")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/geopackage+sqlite3", resp.Header.Get(fiber.HeaderContentType))
+	assert.Contains(t, resp.Header.Get(fiber.HeaderContentDisposition), `filename="roads.gpkg"`)
+	mockExport.AssertExpectations(t)
+}
+
+func TestRoadExport_InvalidParameters(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		status int
+	}{
+		{"missing search term", "/export/roads.fgb", fiber.StatusBadRequest},
+		{"invalid limit", "/export/roads.fgb?q=road&limit=-1", fiber.StatusBadRequest},
+		{"unknown format", "/export/roads.csv?q=road", fiber.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			app, _, mockExport := setupTestAppWithExport()
+			req := httptest.NewRequest("GET", tt.path, nil)
+
+			// Act
+			resp, err := app.Test(req, -1)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.status, resp.StatusCode)
+			mockExport.AssertNotCalled(t, "WriteRoads", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAdminExport_ShapefileIsNotCompressedAgain(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("Write", mock.Anything, mock.Anything, domain.ExportFormatShapefile, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(io.Writer).Write([]byte("PK\x03\x04"))
+		}).Return(nil)
+
+	req := httptest.NewRequest("GET", "/export/admin/2.shp", nil)
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Header.Get(fiber.HeaderContentEncoding))
+	assert.Equal(t, "application/zip", resp.Header.Get(fiber.HeaderContentType))
+	assert.Contains(t, resp.Header.Get(fiber.HeaderContentDisposition), `filename="admin2.shp.zip"`)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "PK\x03\x04", string(body))
+}
+
+func TestRoadExport_KML(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("WriteRoads", mock.Anything, "ถนน", 20, domain.ExportFormatKML, mock.Anything).Return(nil)
+
+	req := httptest.NewRequest("GET", "/export/roads.kml?q=%E0%B8%96%E0%B8%99%E0%B8%99", nil)
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/vnd.google-earth.kml+xml", resp.Header.Get(fiber.HeaderContentType))
+	mockExport.AssertExpectations(t)
+}
@@ -1,6 +1,8 @@
 package http
 
 import (
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -13,13 +15,17 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/recover"
+	"github.com/gorilla/websocket"
 	"github.com/hoshina-dev/gapi/internal/adapters/graph"
 	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
 	"github.com/vektah/gqlparser/v2/ast"
 )
 
-func SetupRouter(resolver *graph.Resolver, cfg infrastructure.Config) *fiber.App {
+func SetupRouter(resolver *graph.Resolver, exportService ports.ExportService, cacheService ports.CacheService, cfg infrastructure.Config) *fiber.App {
 	app := fiber.New()
+	// WebSocket connections are closed by webSocketHandler, not recycled by fasthttp
+	app.Server().KeepHijackedConns = true
 
 	app.Use(recover.New())
 	app.Use(logger.New())
@@ -31,7 +37,20 @@ func SetupRouter(resolver *graph.Resolver, cfg infrastructure.Config) *fiber.App
 
 	app.Get("/health", healthCheck)
 	app.Get("/", playgroundHandler())
-	app.All("/query", graphQLHandler(resolver))
+	app.All("/query", graphQLHandler(resolver, cfg.CorsOrigins, cfg.AdminToken))
+
+	export := app.Group("/export")
+	export.Get("/topojson", topoJSONHandler(exportService, cfg.StrictTolerance))
+	export.Get("/admin/:level.:ext", adminExportHandler(exportService, cfg.StrictTolerance))
+	export.Get("/admin/:level", adminExportHandler(exportService, cfg.StrictTolerance))
+	export.Get("/roads.:ext", roadExportHandler(exportService))
+	export.Get("/roads", roadExportHandler(exportService))
+
+	admin := app.Group("/admin", adminAuth(cfg.AdminToken))
+	admin.Delete("/cache/levels/:level", invalidateCacheLevelHandler(cacheService))
+	admin.Delete("/cache/levels/:level/codes/:code", invalidateCacheCodeHandler(cacheService))
+	admin.Delete("/cache/prefixes/:prefix", invalidateCachePrefixHandler(cacheService))
+	admin.Post("/cache/namespace", renewCacheNamespaceHandler(cacheService))
 
 	return app
 }
@@ -40,9 +59,16 @@ func healthCheck(c *fiber.Ctx) error {
 	return c.JSON(fiber.Map{"status": "ok", "time": time.Now()})
 }
 
-func graphQLHandler(resolver *graph.Resolver) fiber.Handler {
+func graphQLHandler(resolver *graph.Resolver, corsOrigins, adminToken string) fiber.Handler {
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolver}))
 
+	// Subscriptions run over WebSocket, upgraded on a connection hijacked from fasthttp
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool { return originAllowed(corsOrigins, r.Header.Get("Origin")) },
+		},
+	})
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.POST{})
@@ -53,8 +79,30 @@ func graphQLHandler(resolver *graph.Resolver) fiber.Handler {
 	srv.Use(extension.AutomaticPersistedQuery{
 		Cache: lru.New[string](100),
 	})
+	srv.Use(graph.MetricsLoader{})
+
+	httpHandler := adaptor.HTTPHandler(withAdminContext(srv, adminToken))
+	wsHandler := webSocketHandler(srv)
+	return func(c *fiber.Ctx) error {
+		if isWebSocketUpgrade(c) {
+			return wsHandler(c)
+		}
+		return httpHandler(c)
+	}
+}
 
-	return adaptor.HTTPHandler(srv)
+// originAllowed applies the CORS origins to WebSocket upgrades, which browsers do not
+// preflight. Clients that send no Origin header are not browsers and are allowed.
+func originAllowed(corsOrigins, origin string) bool {
+	if origin == "" || corsOrigins == "" || corsOrigins == "*" {
+		return true
+	}
+	for _, allowed := range strings.Split(corsOrigins, ",") {
+		if strings.EqualFold(strings.TrimSpace(allowed), origin) {
+			return true
+		}
+	}
+	return false
 }
 
 func playgroundHandler() fiber.Handler {
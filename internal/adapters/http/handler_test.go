@@ -1,13 +1,17 @@
 package http_test
 
 import (
+	"context"
 	"encoding/json"
 	"io"
+	"net"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gorilla/websocket"
 	"github.com/hoshina-dev/gapi/internal/adapters/graph"
 	"github.com/hoshina-dev/gapi/internal/adapters/graph/mocks"
 	"github.com/hoshina-dev/gapi/internal/adapters/http"
@@ -18,11 +22,44 @@ import (
 )
 
 func setupTestApp() (*fiber.App, *mocks.MockAdminAreaService) {
+	app, mockAdminAreaService, _ := setupTestAppWithExport()
+	return app, mockAdminAreaService
+}
+
+func setupTestAppWithExport() (*fiber.App, *mocks.MockAdminAreaService, *mocks.MockExportService) {
 	cfg := infrastructure.LoadConfig()
 	mockAdminAreaService := new(mocks.MockAdminAreaService)
-	resolver := graph.NewResolver(mockAdminAreaService)
-	app := http.SetupRouter(resolver, cfg)
-	return app, mockAdminAreaService
+	mockExportService := new(mocks.MockExportService)
+	resolver := graph.NewResolver(mockAdminAreaService, new(mocks.MockOSMLineService), mockExportService, new(mocks.MockGeofenceService), new(mocks.MockGeofenceEventService), new(mocks.MockCellService), new(mocks.MockCacheService), cfg)
+	app := http.SetupRouter(resolver, mockExportService, new(mocks.MockCacheService), cfg)
+	return app, mockAdminAreaService, mockExportService
+}
+
+func setupTestAppWithGeofences() (*fiber.App, *mocks.MockGeofenceService) {
+	cfg := infrastructure.LoadConfig()
+	mockGeofenceService := new(mocks.MockGeofenceService)
+	mockExportService := new(mocks.MockExportService)
+	resolver := graph.NewResolver(new(mocks.MockAdminAreaService), new(mocks.MockOSMLineService), mockExportService, mockGeofenceService, new(mocks.MockGeofenceEventService), new(mocks.MockCellService), new(mocks.MockCacheService), cfg)
+	app := http.SetupRouter(resolver, mockExportService, new(mocks.MockCacheService), cfg)
+	return app, mockGeofenceService
+}
+
+func setupTestAppWithCells() (*fiber.App, *mocks.MockCellService) {
+	cfg := infrastructure.LoadConfig()
+	mockCellService := new(mocks.MockCellService)
+	mockExportService := new(mocks.MockExportService)
+	resolver := graph.NewResolver(new(mocks.MockAdminAreaService), new(mocks.MockOSMLineService), mockExportService, new(mocks.MockGeofenceService), new(mocks.MockGeofenceEventService), mockCellService, new(mocks.MockCacheService), cfg)
+	app := http.SetupRouter(resolver, mockExportService, new(mocks.MockCacheService), cfg)
+	return app, mockCellService
+}
+
+func setupTestAppWithGeofenceEvents() (*fiber.App, *mocks.MockGeofenceEventService) {
+	cfg := infrastructure.LoadConfig()
+	mockEventService := new(mocks.MockGeofenceEventService)
+	mockExportService := new(mocks.MockExportService)
+	resolver := graph.NewResolver(new(mocks.MockAdminAreaService), new(mocks.MockOSMLineService), mockExportService, new(mocks.MockGeofenceService), mockEventService, new(mocks.MockCellService), new(mocks.MockCacheService), cfg)
+	app := http.SetupRouter(resolver, mockExportService, new(mocks.MockCacheService), cfg)
+	return app, mockEventService
 }
 
 func TestGraphQLEndpoint_ValidQuery(t *testing.T) {
@@ -233,3 +270,1217 @@ func TestGraphQLEndpoint_ComplexQuery(t *testing.T) {
 	firstAdminArea := adminAreas[0].(map[string]any)
 	assert.Equal(t, "BangkokMetropolis", firstAdminArea["name"])
 }
+
+func TestGraphQLEndpoint_AdminAreaByAddress(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	expectedAdminArea := &domain.AdminArea{
+		ID:         42,
+		Name:       "Mueang Chiang Mai",
+		ISOCode:    "THA.10.1_1",
+		AdminLevel: 2,
+		Geometry:   []byte("geometry"),
+	}
+
+	mockService.On("GetByCode",
+		mock.Anything,
+		"THA.10.1_1",
+		int32(2),
+		mock.Anything,
+	).Return(expectedAdminArea, nil)
+
+	query := `{
+        "query": "query($address: AdminAddressInput) { adminAreaByCode(address: $address, adminLevel: 2) { id name isoCode } }",
+        "variables": {
+            "address": {
+                "country": "Thailand",
+                "admin1": "Chiang Mai",
+                "admin2": "Mueang Chiang Mai",
+                "gid0": "THA",
+                "gid1": "THA.10_1",
+                "gid2": "THA.10.1_1",
+                "ogcFid": 42
+            }
+        }
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	data := result["data"].(map[string]any)
+	adminArea := data["adminAreaByCode"].(map[string]any)
+	assert.Equal(t, "THA.10.1_1", adminArea["isoCode"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_AdminAreaByAddressMissingLevel(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	query := `{
+        "query": "query { adminAreaByCode(address: { gid0: \"THA\", gid1: \"THA.10_1\" }, adminLevel: 3) { id } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.NotNil(t, result["errors"])
+	mockService.AssertNotCalled(t, "GetByCode", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGraphQLEndpoint_AdminAreaMetrics(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	expectedAdminArea := &domain.AdminArea{
+		ID:         10,
+		Name:       "Chiang Mai",
+		ISOCode:    "THA.10_1",
+		AdminLevel: 1,
+	}
+
+	mockService.On("GetByCode",
+		mock.Anything,
+		"THA.10_1",
+		int32(1),
+		mock.Anything,
+	).Return(expectedAdminArea, nil)
+
+	mockService.On("GetMetrics",
+		mock.Anything,
+		[]int{10},
+		int32(1),
+	).Return(map[int]*domain.AdminAreaMetrics{10: {
+		AreaKm2:        20107.0,
+		PerimeterKm:    1024.5,
+		Centroid:       domain.Coordinate{Lat: 18.8, Lon: 98.9},
+		PointOnSurface: domain.Coordinate{Lat: 18.7, Lon: 98.8},
+		BBox:           domain.BBox{MinLon: 98.0, MinLat: 17.2, MaxLon: 99.6, MaxLat: 20.1},
+	}}, nil)
+
+	query := `{
+        "query": "query { adminAreaByCode(code: \"THA.10_1\", adminLevel: 1) { name areaKm2 perimeterKm centroid { lat lon } pointOnSurface { lat lon } bbox { minLon minLat maxLon maxLat } } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	adminArea := data["adminAreaByCode"].(map[string]any)
+	assert.Equal(t, 20107.0, adminArea["areaKm2"])
+	assert.Equal(t, 1024.5, adminArea["perimeterKm"])
+	bbox := adminArea["bbox"].(map[string]any)
+	assert.Equal(t, 99.6, bbox["maxLon"])
+	centroid := adminArea["centroid"].(map[string]any)
+	assert.Equal(t, 18.8, centroid["lat"])
+	// The five metric fields share one lookup
+	mockService.AssertNumberOfCalls(t, "GetMetrics", 1)
+}
+
+func TestGraphQLEndpoint_GeometryOmittedWhenNotSelected(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("GetAll",
+		mock.Anything,
+		int32(2),
+		mock.MatchedBy(func(opts domain.GeometryOptions) bool { return opts.Omit }),
+	).Return([]*domain.AdminArea{{ID: 1, Name: "Mueang", ISOCode: "THA.1.1_1", AdminLevel: 2}}, nil)
+
+	query := `{
+        "query": "query { adminAreas(adminLevel: 2) { name isoCode } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_GeometryLoadedWhenSelected(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("GetAll",
+		mock.Anything,
+		int32(2),
+		mock.MatchedBy(func(opts domain.GeometryOptions) bool {
+			return !opts.Omit && opts.Tolerance != nil && *opts.Tolerance == 0.01
+		}),
+	).Return([]*domain.AdminArea{{ID: 1, Name: "Mueang", ISOCode: "THA.1.1_1", AdminLevel: 2, Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)}}, nil)
+
+	query := `{
+        "query": "query { adminAreas(adminLevel: 2, tolerance: 0.01) { name ... on AdminArea { geometry } } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_CoverageSimplification(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("GetChildren",
+		mock.Anything,
+		"THA.10_1",
+		int32(2),
+		mock.MatchedBy(func(opts domain.GeometryOptions) bool {
+			return opts.Simplification == domain.SimplificationCoverage && *opts.Tolerance == 0.01
+		}),
+	).Return([]*domain.AdminArea{{ID: 1, Name: "Mueang", ISOCode: "THA.10.1_1", AdminLevel: 2, Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)}}, nil)
+
+	query := `{
+        "query": "query { childrenByCode(parentCode: \"THA.10_1\", childLevel: 2, tolerance: 0.01, simplification: COVERAGE) { name geometry } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_CoverageSimplificationRejectsNonStandardTolerance(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	query := `{
+        "query": "query { adminAreas(adminLevel: 1, tolerance: 0.0123, simplification: COVERAGE) { name } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.NotNil(t, result["errors"])
+	mockService.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGraphQLEndpoint_ZoomMapsToTolerancePreset(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("GetAll",
+		mock.Anything,
+		int32(1),
+		mock.MatchedBy(func(opts domain.GeometryOptions) bool {
+			return opts.Tolerance != nil && *opts.Tolerance == domain.ZoomTolerances[5]
+		}),
+	).Return([]*domain.AdminArea{}, nil)
+
+	query := `{
+        "query": "query { adminAreas(adminLevel: 1, zoom: 5) { name geometry } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_ZoomAndToleranceConflict(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	query := `{
+        "query": "query { adminAreas(adminLevel: 1, zoom: 5, tolerance: 0.01) { name } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.NotNil(t, result["errors"])
+	mockService.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGraphQLEndpoint_StrictToleranceRejectsArbitraryValues(t *testing.T) {
+	// Arrange
+	cfg := infrastructure.LoadConfig()
+	cfg.StrictTolerance = true
+	mockService := new(mocks.MockAdminAreaService)
+	mockExportService := new(mocks.MockExportService)
+	app := http.SetupRouter(graph.NewResolver(mockService, new(mocks.MockOSMLineService), mockExportService, new(mocks.MockGeofenceService), new(mocks.MockGeofenceEventService), new(mocks.MockCellService), new(mocks.MockCacheService), cfg), mockExportService, new(mocks.MockCacheService), cfg)
+
+	query := `{
+        "query": "query { adminAreas(adminLevel: 1, tolerance: 0.0123) { name } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.NotNil(t, result["errors"])
+	mockService.AssertNotCalled(t, "GetAll", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGraphQLEndpoint_Topology(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+
+	mockExport.On("TopoJSON",
+		mock.Anything,
+		mock.MatchedBy(func(scope domain.ExportScope) bool {
+			return scope.AdminLevel == 1 && scope.ParentCode == nil && !scope.Geometry.Omit
+		}),
+		10000,
+	).Return([]byte(`{"type":"Topology","objects":{},"arcs":[]}`), nil)
+
+	query := `{
+        "query": "query { topology(adminLevel: 1) }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	data := result["data"].(map[string]any)
+	topology := data["topology"].(map[string]any)
+	assert.Equal(t, "Topology", topology["type"])
+	mockExport.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_FilterCoordinatesByGeometry(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("FilterCoordinatesByGeometry",
+		mock.Anything,
+		mock.MatchedBy(func(coords []*domain.Coordinate) bool {
+			return len(coords) == 2 && coords[0].ID == "a" && coords[1].Lat == 20
+		}),
+		mock.MatchedBy(func(geometry []byte) bool {
+			polygon := map[string]any{}
+			return json.Unmarshal(geometry, &polygon) == nil && polygon["type"] == "Polygon"
+		}),
+	).Return([]*domain.Coordinate{{ID: "a", Lat: 13.5, Lon: 100.5}}, nil)
+
+	query := `{
+        "query": "query($geometry: Map!) { filterCoordinatesByGeometry(coordinates: [{id: \"a\", lat: 13.5, lon: 100.5}, {id: \"b\", lat: 20, lon: 100.5}], geometry: $geometry) { id lat lon } }",
+        "variables": {"geometry": {"type": "Polygon", "coordinates": [[[100, 13], [101, 13], [101, 14], [100, 14], [100, 13]]]}}
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	coordinates := data["filterCoordinatesByGeometry"].([]any)
+	assert.Len(t, coordinates, 1)
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_FilterCoordinatesByGeometryRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		geometry string
+	}{
+		{"point", `{"type": "Point", "coordinates": [100, 13]}`},
+		{"unclosed ring", `{"type": "Polygon", "coordinates": [[[100, 13], [101, 13], [100, 13]]]}`},
+		{"latitude out of range", `{"type": "Polygon", "coordinates": [[[100, 13], [101, 95], [101, 14], [100, 13]]]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			app, mockService := setupTestApp()
+
+			query := `{
+                "query": "query($geometry: Map!) { filterCoordinatesByGeometry(coordinates: [{id: \"a\", lat: 13.5, lon: 100.5}], geometry: $geometry) { id } }",
+                "variables": {"geometry": ` + tt.geometry + `}
+            }`
+
+			req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Act
+			resp, err := app.Test(req, -1)
+
+			// Assert
+			assert.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			var result map[string]any
+			json.Unmarshal(body, &result)
+
+			assert.NotNil(t, result["errors"])
+			mockService.AssertNotCalled(t, "FilterCoordinatesByGeometry", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGraphQLEndpoint_Neighbors(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("GetByCode",
+		mock.Anything,
+		"THA.10_1",
+		int32(1),
+		domain.GeometryOptions{Omit: true},
+	).Return(&domain.AdminArea{ID: 10, Name: "Chiang Mai", ISOCode: "THA.10_1", AdminLevel: 1}, nil)
+
+	mockService.On("GetNeighbors",
+		mock.Anything,
+		10,
+		int32(1),
+		mock.MatchedBy(func(opts domain.GeometryOptions) bool { return opts.Omit }),
+	).Return([]*domain.AdminAreaNeighbor{
+		{Area: &domain.AdminArea{ID: 11, Name: "Chiang Rai", ISOCode: "THA.11_1", AdminLevel: 1}, SharedBorderKm: 180.2},
+		{Area: &domain.AdminArea{ID: 40, Name: "Mae Hong Son", ISOCode: "THA.40_1", AdminLevel: 1}, SharedBorderKm: 150.7},
+	}, nil)
+
+	query := `{
+        "query": "query { neighbors(code: \"THA.10_1\", level: 1) { sharedBorderKm area { name isoCode } } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	neighbors := data["neighbors"].([]any)
+	assert.Len(t, neighbors, 2)
+	first := neighbors[0].(map[string]any)
+	assert.Equal(t, 180.2, first["sharedBorderKm"])
+	assert.Equal(t, "Chiang Rai", first["area"].(map[string]any)["name"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_AdminAreaNeighborsWithGeometry(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("GetByID",
+		mock.Anything,
+		10,
+		int32(1),
+		mock.Anything,
+	).Return(&domain.AdminArea{ID: 10, Name: "Chiang Mai", ISOCode: "THA.10_1", AdminLevel: 1}, nil)
+
+	mockService.On("GetNeighbors",
+		mock.Anything,
+		10,
+		int32(1),
+		mock.MatchedBy(func(opts domain.GeometryOptions) bool {
+			return !opts.Omit && opts.Tolerance != nil && *opts.Tolerance == 0.01
+		}),
+	).Return([]*domain.AdminAreaNeighbor{
+		{Area: &domain.AdminArea{ID: 11, Name: "Chiang Rai", AdminLevel: 1, Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)}, SharedBorderKm: 180.2},
+	}, nil)
+
+	query := `{
+        "query": "query { adminArea(id: \"10\", adminLevel: 1) { name neighbors(tolerance: 0.01) { sharedBorderKm area { name geometry } } } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	adminArea := result["data"].(map[string]any)["adminArea"].(map[string]any)
+	neighbors := adminArea["neighbors"].([]any)
+	assert.Len(t, neighbors, 1)
+	area := neighbors[0].(map[string]any)["area"].(map[string]any)
+	assert.Equal(t, "MultiPolygon", area["geometry"].(map[string]any)["type"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_DatasetSelectsRelease(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	inDataset := func(name string) any {
+		return mock.MatchedBy(func(ctx context.Context) bool { return domain.DatasetFromContext(ctx) == name })
+	}
+	mockService.On("GetByCode",
+		inDataset("gadm36"),
+		"THA.10_1",
+		int32(1),
+		mock.Anything,
+	).Return(&domain.AdminArea{ID: 10, Name: "Chiang Mai", ISOCode: "THA.10_1", AdminLevel: 1, Dataset: "gadm36"}, nil)
+
+	// Fields of the area read from the dataset it came from
+	mockService.On("GetMetrics",
+		inDataset("gadm36"),
+		[]int{10},
+		int32(1),
+	).Return(map[int]*domain.AdminAreaMetrics{10: {AreaKm2: 20107.0}}, nil)
+
+	query := `{
+        "query": "query { adminAreaByCode(code: \"THA.10_1\", adminLevel: 1, dataset: \"gadm36\") { name dataset areaKm2 } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	area := result["data"].(map[string]any)["adminAreaByCode"].(map[string]any)
+	assert.Equal(t, "gadm36", area["dataset"])
+	assert.Equal(t, 20107.0, area["areaKm2"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_Crosswalk(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	mockService.On("Crosswalk",
+		mock.Anything,
+		"THA.10",
+		int32(1),
+		"gadm36",
+		"",
+		domain.GeometryOptions{Omit: true},
+	).Return([]*domain.CrosswalkMatch{
+		{Area: &domain.AdminArea{ID: 10, Name: "Chiang Mai", ISOCode: "THA.10_1", AdminLevel: 1}, SourceShare: 0.998, TargetShare: 0.997},
+	}, nil)
+
+	query := `{
+        "query": "query { crosswalk(code: \"THA.10\", level: 1, from: \"gadm36\") { sourceShare targetShare area { isoCode } } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	matches := result["data"].(map[string]any)["crosswalk"].([]any)
+	assert.Len(t, matches, 1)
+	first := matches[0].(map[string]any)
+	assert.Equal(t, 0.998, first["sourceShare"])
+	assert.Equal(t, "THA.10_1", first["area"].(map[string]any)["isoCode"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_AggregateCoordinates(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	sum := 7.5
+	mockService.On("AggregateCoordinates",
+		mock.Anything,
+		mock.MatchedBy(func(coords []*domain.Coordinate) bool {
+			return len(coords) == 2 && coords[0].Value != nil && *coords[0].Value == 7.5 && coords[1].Value == nil
+		}),
+		int32(1),
+		mock.MatchedBy(func(parentCode *string) bool { return parentCode != nil && *parentCode == "THA" }),
+	).Return([]*domain.AreaAggregate{
+		{GID: "THA.1_1", Name: "Bangkok", AdminLevel: 1, Count: 2, Sum: &sum, AreaKm2: 1500, Density: 2.0 / 1500},
+	}, nil)
+
+	query := `{
+        "query": "query { aggregateCoordinates(coordinates: [{id: \"a\", lat: 13.7, lon: 100.5, value: 7.5}, {id: \"b\", lat: 13.8, lon: 100.6}], level: 1, parentCode: \"THA\") { gid name count sum density } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	aggregates := data["aggregateCoordinates"].([]any)
+	assert.Len(t, aggregates, 1)
+	bangkok := aggregates[0].(map[string]any)
+	assert.EqualValues(t, 2, bangkok["count"])
+	assert.Equal(t, 7.5, bangkok["sum"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_AggregateCoordinatesRejectsParentBelowLevel(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	query := `{
+        "query": "query { aggregateCoordinates(coordinates: [{id: \"a\", lat: 13.7, lon: 100.5}], level: 1, parentCode: \"THA.1_1\") { gid } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.NotNil(t, result["errors"])
+	mockService.AssertNotCalled(t, "AggregateCoordinates", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGraphQLEndpoint_AreaCells(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithCells()
+
+	mockService.On("Polyfill", mock.Anything, domain.CellGridGeohash, "THA.1_1", int32(1), 5).
+		Return([]string{"w4rqn", "w4rqp"}, nil)
+
+	query := `{
+        "query": "query { areaCells(code: \"THA.1_1\", level: 1, resolution: 5) }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	assert.Equal(t, []any{"w4rqn", "w4rqp"}, data["areaCells"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_AreaCellsH3(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithCells()
+
+	mockService.On("Polyfill", mock.Anything, domain.CellGridH3, "THA.1_1", int32(1), 0).
+		Return([]string{"8065fffffffffff"}, nil)
+
+	query := `{
+        "query": "query { areaCells(code: \"THA.1_1\", level: 1, resolution: 0, grid: H3) }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	assert.Equal(t, []any{"8065fffffffffff"}, data["areaCells"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_AreaCellsRejectsResolution(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithCells()
+
+	query := `{
+        "query": "query { areaCells(code: \"THA.1_1\", level: 1, resolution: 13) }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.NotNil(t, result["errors"])
+	mockService.AssertNotCalled(t, "Polyfill", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGraphQLEndpoint_CellAreas(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithCells()
+
+	mockService.On("CellAreas", mock.Anything, domain.CellGridGeohash, "w4rqn", int32(1)).
+		Return([]*domain.CellCoverage{
+			{Area: &domain.AdminArea{ID: 1, Name: "Bangkok", ISOCode: "THA.1_1", AdminLevel: 1}, Fraction: 0.8},
+			{Area: &domain.AdminArea{ID: 58, Name: "Samut Prakan", ISOCode: "THA.58_1", AdminLevel: 1}, Fraction: 0.2},
+		}, nil)
+
+	query := `{
+        "query": "query { cellAreas(cell: \"w4rqn\", level: 1, grid: GEOHASH) { fraction area { name isoCode } } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	coverages := data["cellAreas"].([]any)
+	assert.Len(t, coverages, 2)
+	first := coverages[0].(map[string]any)
+	assert.Equal(t, 0.8, first["fraction"])
+	assert.Equal(t, "THA.1_1", first["area"].(map[string]any)["isoCode"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_ClipByBoundaries(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestApp()
+
+	lengthKm := 42.5
+	mockService.On("ClipByBoundaries",
+		mock.Anything,
+		mock.MatchedBy(func(geometry []byte) bool {
+			line := map[string]any{}
+			return json.Unmarshal(geometry, &line) == nil && line["type"] == "LineString"
+		}),
+		int32(1),
+	).Return([]*domain.ClippedArea{{
+		GID:        "THA.1_1",
+		Name:       "Bangkok",
+		AdminLevel: 1,
+		Geometry:   []byte(`{"type":"LineString","coordinates":[[100.5,13.7],[100.6,13.8]]}`),
+		LengthKm:   &lengthKm,
+	}}, nil)
+
+	query := `{
+        "query": "query($geometry: Map!) { clipByBoundaries(geometry: $geometry, level: 1) { gid name adminLevel lengthKm areaKm2 geometry } }",
+        "variables": {"geometry": {"type": "LineString", "coordinates": [[100.5, 13.7], [101, 14]]}}
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	data := result["data"].(map[string]any)
+	areas := data["clipByBoundaries"].([]any)
+	assert.Len(t, areas, 1)
+	area := areas[0].(map[string]any)
+	assert.Equal(t, "THA.1_1", area["gid"])
+	assert.Equal(t, 42.5, area["lengthKm"])
+	assert.Nil(t, area["areaKm2"])
+	assert.Equal(t, "LineString", area["geometry"].(map[string]any)["type"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_ClipByBoundariesRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name     string
+		geometry string
+	}{
+		{"point", `{"type": "Point", "coordinates": [100, 13]}`},
+		{"single position line", `{"type": "LineString", "coordinates": [[100, 13]]}`},
+		{"longitude out of range", `{"type": "MultiLineString", "coordinates": [[[100, 13], [181, 13]]]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			app, mockService := setupTestApp()
+
+			query := `{
+                "query": "query($geometry: Map!) { clipByBoundaries(geometry: $geometry, level: 1) { gid } }",
+                "variables": {"geometry": ` + tt.geometry + `}
+            }`
+
+			req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Act
+			resp, err := app.Test(req, -1)
+
+			// Assert
+			assert.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			var result map[string]any
+			json.Unmarshal(body, &result)
+
+			assert.NotNil(t, result["errors"])
+			mockService.AssertNotCalled(t, "ClipByBoundaries", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGraphQLEndpoint_CreateGeofenceFromBoundaries(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithGeofences()
+
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockService.On("Create",
+		mock.Anything,
+		mock.MatchedBy(func(input domain.GeofenceDefinition) bool {
+			return input.Name == "Central" && len(input.Geometry) == 0 && len(input.BoundaryCodes) == 2
+		}),
+	).Return(&domain.Geofence{
+		ID:            3,
+		Name:          "Central",
+		BoundaryCodes: []string{"THA.3_1", "THA.4_1"},
+		Geometry:      []byte(`{"type":"MultiPolygon","coordinates":[]}`),
+		CreatedAt:     created,
+		UpdatedAt:     created,
+	}, nil)
+
+	query := `{
+        "query": "mutation { createGeofence(input: {name: \"Central\", boundaryCodes: [\"THA.3_1\", \"THA.4_1\"]}) { id name boundaryCodes createdAt } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, fiber.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	geofence := result["data"].(map[string]any)["createGeofence"].(map[string]any)
+	assert.EqualValues(t, 3, geofence["id"])
+	assert.Equal(t, []any{"THA.3_1", "THA.4_1"}, geofence["boundaryCodes"])
+	assert.Equal(t, "2026-01-02T03:04:05Z", geofence["createdAt"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_CreateGeofenceRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"point geometry", `{"name": "zone", "geometry": {"type": "Point", "coordinates": [100, 13]}}`},
+		{"malformed boundary code", `{"name": "zone", "boundaryCodes": ["THAILAND.1"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			app, mockService := setupTestAppWithGeofences()
+
+			query := `{
+                "query": "mutation($input: GeofenceInput!) { createGeofence(input: $input) { id } }",
+                "variables": {"input": ` + tt.input + `}
+            }`
+
+			req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Act
+			resp, err := app.Test(req, -1)
+
+			// Assert
+			assert.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			var result map[string]any
+			json.Unmarshal(body, &result)
+
+			assert.NotNil(t, result["errors"])
+			mockService.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGraphQLEndpoint_DeleteGeofence(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithGeofences()
+	mockService.On("Delete", mock.Anything, 3).Return(nil)
+
+	query := `{
+        "query": "mutation { deleteGeofence(id: 3) }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Equal(t, true, result["data"].(map[string]any)["deleteGeofence"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_MatchGeofences(t *testing.T) {
+	tests := []struct {
+		name      string
+		selection string
+		omit      bool
+	}{
+		{"geometry not selected", "id name", true},
+		{"geometry selected", "id geometry", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			app, mockService := setupTestAppWithGeofences()
+
+			zone := &domain.Geofence{ID: 7, Name: "zone", Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)}
+			mockService.On("Match",
+				mock.Anything,
+				mock.MatchedBy(func(coords []*domain.Coordinate) bool { return len(coords) == 2 }),
+				mock.MatchedBy(func(opts domain.GeometryOptions) bool { return opts.Omit == tt.omit }),
+			).Return([]*domain.GeofenceMatch{
+				{Coordinate: &domain.Coordinate{ID: "a", Lat: 13.5, Lon: 100.5}, Geofences: []*domain.Geofence{zone}},
+				{Coordinate: &domain.Coordinate{ID: "b", Lat: 20, Lon: 100.5}, Geofences: []*domain.Geofence{}},
+			}, nil)
+
+			query := `{
+                "query": "query { matchGeofences(coordinates: [{id: \"a\", lat: 13.5, lon: 100.5}, {id: \"b\", lat: 20, lon: 100.5}]) { coordinate { id } geofences { ` + tt.selection + ` } } }"
+            }`
+
+			req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+			req.Header.Set("Content-Type", "application/json")
+
+			// Act
+			resp, err := app.Test(req, -1)
+
+			// Assert
+			assert.NoError(t, err)
+
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+			var result map[string]any
+			json.Unmarshal(body, &result)
+
+			assert.Nil(t, result["errors"])
+			matches := result["data"].(map[string]any)["matchGeofences"].([]any)
+			assert.Len(t, matches, 2)
+			assert.Len(t, matches[0].(map[string]any)["geofences"], 1)
+			assert.Empty(t, matches[1].(map[string]any)["geofences"])
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGraphQLEndpoint_ReportPosition(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithGeofenceEvents()
+
+	reportedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockService.On("ReportPosition", mock.Anything, "truck-1", 13.5, 100.5, reportedAt).Return([]*domain.GeofenceEvent{
+		{Type: domain.GeofenceEventEnter, DeviceID: "truck-1", GeofenceID: 7, GeofenceName: "zone", Lat: 13.5, Lon: 100.5, Timestamp: reportedAt},
+	}, nil)
+
+	query := `{
+        "query": "mutation { reportPosition(deviceId: \"truck-1\", lat: 13.5, lon: 100.5, timestamp: \"2026-01-02T03:04:05Z\") { type geofenceId geofenceName } }"
+    }`
+
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+
+	assert.Nil(t, result["errors"])
+	events := result["data"].(map[string]any)["reportPosition"].([]any)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "ENTER", events[0].(map[string]any)["type"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_GeofenceEventsSubscription(t *testing.T) {
+	// Arrange
+	app, mockService := setupTestAppWithGeofenceEvents()
+
+	events := make(chan *domain.GeofenceEvent, 1)
+	mockService.On("Subscribe",
+		mock.Anything,
+		domain.GeofenceEventFilter{DeviceIDs: []string{"truck-1"}, GeofenceIDs: []int{7}},
+	).Return((<-chan *domain.GeofenceEvent)(events), nil)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go app.Listener(ln)
+	defer ln.Close()
+
+	dialer := websocket.Dialer{Subprotocols: []string{"graphql-transport-ws"}}
+	conn, _, err := dialer.Dial("ws://"+ln.Addr().String()+"/query", nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+
+	// Act
+	assert.NoError(t, conn.WriteJSON(map[string]any{"type": "connection_init"}))
+	var ack map[string]any
+	assert.NoError(t, conn.ReadJSON(&ack))
+	assert.Equal(t, "connection_ack", ack["type"])
+
+	assert.NoError(t, conn.WriteJSON(map[string]any{
+		"id":   "1",
+		"type": "subscribe",
+		"payload": map[string]any{
+			"query": `subscription { geofenceEvents(deviceIds: ["truck-1"], boundaryIds: ["7"]) { type deviceId geofenceId } }`,
+		},
+	}))
+	events <- &domain.GeofenceEvent{Type: domain.GeofenceEventExit, DeviceID: "truck-1", GeofenceID: 7}
+
+	// Assert
+	var next struct {
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Payload struct {
+			Data struct {
+				GeofenceEvents map[string]any `json:"geofenceEvents"`
+			} `json:"data"`
+		} `json:"payload"`
+	}
+	assert.NoError(t, conn.ReadJSON(&next))
+	assert.Equal(t, "next", next.Type)
+	assert.Equal(t, "1", next.ID)
+	assert.Equal(t, "EXIT", next.Payload.Data.GeofenceEvents["type"])
+	assert.Equal(t, "truck-1", next.Payload.Data.GeofenceEvents["deviceId"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGraphQLEndpoint_TopologyOfDataset(t *testing.T) {
+	// Arrange
+	app, _, mockExport := setupTestAppWithExport()
+	mockExport.On("TopoJSON", inDataset("gadm36"), mock.Anything, 10000).
+		Return([]byte(`{"type":"Topology","objects":{},"arcs":[]}`), nil)
+
+	query := `{"query": "query { topology(adminLevel: 1, dataset: \"gadm36\") }"}`
+	req := httptest.NewRequest("POST", "/query", strings.NewReader(query))
+	req.Header.Set("Content-Type", "application/json")
+
+	// Act
+	resp, err := app.Test(req, -1)
+
+	// Assert
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	var result map[string]any
+	json.Unmarshal(body, &result)
+	assert.Nil(t, result["errors"])
+	mockExport.AssertExpectations(t)
+}
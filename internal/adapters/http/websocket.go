@@ -0,0 +1,81 @@
+package http
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// isWebSocketUpgrade reports whether the request asks to switch to the WebSocket protocol
+func isWebSocketUpgrade(c *fiber.Ctx) bool {
+	return strings.EqualFold(c.Get(fiber.HeaderUpgrade), "websocket")
+}
+
+// webSocketHandler serves a WebSocket upgrade with a net/http handler on the connection
+// hijacked from fasthttp. The request is copied rather than converted in place: fasthttp
+// recycles the request context and its buffers once the handler returns, while the
+// upgraded connection, and the subscriptions on it, live on. The server must keep
+// hijacked connections, see SetupRouter, so a subscription finishing after the client
+// left writes to a closed connection rather than one fasthttp handed to someone else.
+func webSocketHandler(h http.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		ctx, cancel := context.WithCancel(context.Background())
+		req, err := http.NewRequestWithContext(ctx, c.Method(), string(c.Request().URI().FullURI()), nil)
+		if err != nil {
+			cancel()
+			return err
+		}
+		for key, value := range c.Request().Header.All() {
+			req.Header.Add(string(key), string(value))
+		}
+		req.Host = string(c.Request().Host())
+		req.RemoteAddr = c.Context().RemoteAddr().String()
+
+		c.Context().HijackSetNoResponse(true)
+		c.Context().Hijack(func(conn net.Conn) {
+			defer conn.Close()
+			defer cancel()
+			h.ServeHTTP(&hijackedResponse{conn: conn, header: http.Header{}}, req)
+		})
+		return nil
+	}
+}
+
+// hijackedResponse is the http.ResponseWriter of a hijacked connection. The upgrader
+// takes the connection over through Hijack; Write and WriteHeader only serve error
+// responses, after which the connection is closed.
+type hijackedResponse struct {
+	conn        net.Conn
+	header      http.Header
+	wroteHeader bool
+}
+
+func (w *hijackedResponse) Header() http.Header {
+	return w.header
+}
+
+func (w *hijackedResponse) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.header.Set(fiber.HeaderConnection, "close")
+	fmt.Fprintf(w.conn, "HTTP/1.1 %d %s\r\n", status, http.StatusText(status))
+	w.header.Write(w.conn)
+	io.WriteString(w.conn, "\r\n")
+}
+
+func (w *hijackedResponse) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.conn.Write(p)
+}
+
+func (w *hijackedResponse) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.conn, bufio.NewReadWriter(bufio.NewReader(w.conn), bufio.NewWriter(w.conn)), nil
+}
@@ -0,0 +1,502 @@
+package importer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"maps"
+	"net/url"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/migrations"
+	"gorm.io/gorm"
+)
+
+// stagingSchema receives the layers of the source file as ogr2ogr writes them
+const stagingSchema = "gadm_import"
+
+// importLockKey is the advisory lock held for the whole of an import, as imports share
+// the staging schema and the new tables. It follows the keys of the migrations.
+const importLockKey = 7_246_503
+
+// importSuffix marks the tables built next to the live ones until they are swapped in
+const importSuffix = "_import"
+
+// gidPattern matches the GID columns of a GADM layer, e.g. gid_2
+var gidPattern = regexp.MustCompile(`^gid_(\d)$`)
+
+// GADMOptions selects what Import loads
+type GADMOptions struct {
+	// File is a GADM GeoPackage or Shapefile
+	File string
+	// Levels are the admin levels to import; each replaces its table
+	Levels []int32
+	// Dataset is the schema the tables are created in, "" for the unqualified tables
+	Dataset string
+}
+
+// GADMImporter loads GADM boundaries into the admin0 to admin4 tables. Reading the
+// file is left to ogr2ogr from GDAL, which understands every format GADM ships.
+type GADMImporter struct {
+	db      *gorm.DB
+	dsn     string
+	ogr2ogr string
+}
+
+func NewGADMImporter(db *gorm.DB, dsn string) *GADMImporter {
+	return &GADMImporter{db: db, dsn: dsn, ogr2ogr: "ogr2ogr"}
+}
+
+// CheckTools ensures ogr2ogr can be run, so an import fails before touching the database
+func (i *GADMImporter) CheckTools() error {
+	if _, err := exec.LookPath(i.ogr2ogr); err != nil {
+		return fmt.Errorf("%s not found; install GDAL (gdal-tools and gdal-driver-pg on Alpine, gdal-bin on Debian) to import GADM files: %w", i.ogr2ogr, err)
+	}
+	return nil
+}
+
+// Import loads the file into a staging schema, builds each level into a new table
+// with its indexes, and only then swaps the tables in, all levels in one transaction.
+// The API keeps serving the old tables until the swap and never sees a partial import.
+// Imports into any dataset run one at a time.
+func (i *GADMImporter) Import(ctx context.Context, opts GADMOptions) error {
+	if len(opts.Levels) == 0 {
+		return errors.New("no admin levels to import")
+	}
+	if err := i.CheckTools(); err != nil {
+		return err
+	}
+	if _, err := os.Stat(opts.File); err != nil {
+		return err
+	}
+
+	return i.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		var locked bool
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?)", importLockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			log.Println("Waiting for another import to finish...")
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", importLockKey).Error; err != nil {
+				return err
+			}
+		}
+		defer func() {
+			// The connection returns to the pool, so the lock is released even when the import was cancelled
+			if err := conn.WithContext(context.WithoutCancel(ctx)).Exec("SELECT pg_advisory_unlock(?)", importLockKey).Error; err != nil {
+				log.Printf("Failed to release the import lock: %v", err)
+			}
+		}()
+		return i.load(ctx, opts)
+	})
+}
+
+// load runs an import while holding the import lock
+func (i *GADMImporter) load(ctx context.Context, opts GADMOptions) error {
+	if err := i.db.WithContext(ctx).Exec("DROP SCHEMA IF EXISTS " + stagingSchema + " CASCADE").Error; err != nil {
+		return err
+	}
+	defer func() {
+		if err := i.db.Exec("DROP SCHEMA IF EXISTS " + stagingSchema + " CASCADE").Error; err != nil {
+			log.Printf("Failed to drop %s schema: %v", stagingSchema, err)
+		}
+	}()
+
+	statements := []string{
+		"CREATE SCHEMA " + stagingSchema,
+		"CREATE EXTENSION IF NOT EXISTS pg_trgm",
+	}
+	for _, sql := range statements {
+		if err := i.db.WithContext(ctx).Exec(sql).Error; err != nil {
+			return err
+		}
+	}
+	// The dataset schema comes with the precomputed tables its queries read
+	if err := migrations.MigrateDataset(ctx, i.db, opts.Dataset); err != nil {
+		return err
+	}
+
+	conninfo, password, err := splitPassword(i.dsn)
+	if err != nil {
+		return fmt.Errorf("parse the connection string: %w", err)
+	}
+	log.Printf("Loading %s...", opts.File)
+	cmd := exec.CommandContext(ctx, i.ogr2ogr, ogr2ogrArgs(conninfo, opts.File)...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	// The password goes through the environment, which unlike the arguments is not
+	// visible to other users of the host
+	if password != "" {
+		cmd.Env = append(os.Environ(), "PGPASSWORD="+password)
+	}
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("ogr2ogr: %w", err)
+	}
+
+	layers, err := i.stagedLayers(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, level := range opts.Levels {
+		layer, ok := pickLayer(layers, level)
+		if !ok {
+			return fmt.Errorf("%s has no layer with admin level %d", opts.File, level)
+		}
+		log.Printf("Building admin level %d from layer %s...", level, layer.Name)
+		if err := i.build(ctx, layer, level, opts.Dataset); err != nil {
+			return fmt.Errorf("build admin level %d: %w", level, err)
+		}
+	}
+
+	log.Println("Swapping tables...")
+	return i.swap(ctx, opts.Levels, opts.Dataset)
+}
+
+// ogr2ogrArgs loads every layer of the file into the staging schema as MultiPolygons
+// in WGS84, with the ogc_fid and geom columns the admin tables use
+func ogr2ogrArgs(conninfo, file string) []string {
+	return []string{
+		"-f", "PostgreSQL", "PG:" + conninfo, file,
+		"-lco", "SCHEMA=" + stagingSchema,
+		"-lco", "GEOMETRY_NAME=geom",
+		"-lco", "FID=ogc_fid",
+		"-lco", "SPATIAL_INDEX=NONE",
+		"-nlt", "PROMOTE_TO_MULTI",
+		"-t_srs", "EPSG:4326",
+		"-overwrite",
+		"-progress",
+	}
+}
+
+// splitPassword takes the password out of a connection string, given as keywords and
+// values or as a URI, and returns the other settings as keywords and values
+func splitPassword(dsn string) (conninfo, password string, err error) {
+	var settings [][2]string
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		settings, err = uriSettings(dsn)
+	} else {
+		settings, err = keywordSettings(dsn)
+	}
+	if err != nil {
+		return "", "", err
+	}
+
+	var parts []string
+	for _, setting := range settings {
+		if setting[0] == "password" {
+			password = setting[1]
+			continue
+		}
+		value := strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(setting[1])
+		parts = append(parts, setting[0]+"='"+value+"'")
+	}
+	return strings.Join(parts, " "), password, nil
+}
+
+// uriSettings reads the settings of a postgres:// URI
+func uriSettings(dsn string) ([][2]string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, err
+	}
+	var settings [][2]string
+	add := func(key, value string) {
+		if value != "" {
+			settings = append(settings, [2]string{key, value})
+		}
+	}
+	add("host", u.Hostname())
+	add("port", u.Port())
+	add("dbname", strings.TrimPrefix(u.Path, "/"))
+	if u.User != nil {
+		add("user", u.User.Username())
+		password, _ := u.User.Password()
+		add("password", password)
+	}
+	query := u.Query()
+	keys := slices.Sorted(maps.Keys(query))
+	for _, key := range keys {
+		add(key, query.Get(key))
+	}
+	return settings, nil
+}
+
+// keywordSettings reads the settings of a keyword=value connection string, whose
+// values are either quoted with escapes or end at the next space
+func keywordSettings(dsn string) ([][2]string, error) {
+	var settings [][2]string
+	s := strings.TrimSpace(dsn)
+	for s != "" {
+		key, rest, ok := strings.Cut(s, "=")
+		if !ok {
+			return nil, fmt.Errorf("missing = after %q", s)
+		}
+		key, rest = strings.TrimSpace(key), strings.TrimLeft(rest, " \t\n")
+
+		var value strings.Builder
+		if strings.HasPrefix(rest, "'") {
+			closed := false
+			for n := 1; n < len(rest); n++ {
+				switch c := rest[n]; {
+				case c == '\\' && n+1 < len(rest):
+					n++
+					value.WriteByte(rest[n])
+				case c == '\'':
+					closed, rest = true, rest[n+1:]
+				default:
+					value.WriteByte(c)
+				}
+				if closed {
+					break
+				}
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated quoted value of %s", key)
+			}
+		} else {
+			end := strings.IndexAny(rest, " \t\n")
+			if end < 0 {
+				end = len(rest)
+			}
+			value.WriteString(rest[:end])
+			rest = rest[end:]
+		}
+		settings = append(settings, [2]string{key, value.String()})
+		s = strings.TrimSpace(rest)
+	}
+	return settings, nil
+}
+
+// stagedLayer is a table ogr2ogr created and the columns it has
+type stagedLayer struct {
+	Name    string
+	Columns []string
+}
+
+// maxLevel returns the deepest admin level the layer has a GID column for
+func (l stagedLayer) maxLevel() int32 {
+	level := int32(-1)
+	for _, column := range l.Columns {
+		if m := gidPattern.FindStringSubmatch(column); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			level = max(level, int32(n))
+		}
+	}
+	return level
+}
+
+// stagedLayers lists the tables of the staging schema with their columns
+func (i *GADMImporter) stagedLayers(ctx context.Context) ([]stagedLayer, error) {
+	var rows []struct {
+		TableName  string
+		ColumnName string
+	}
+	err := i.db.WithContext(ctx).Raw(
+		"SELECT table_name, column_name FROM information_schema.columns WHERE table_schema = ? ORDER BY table_name, ordinal_position",
+		stagingSchema,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var layers []stagedLayer
+	for _, row := range rows {
+		if len(layers) == 0 || layers[len(layers)-1].Name != row.TableName {
+			layers = append(layers, stagedLayer{Name: row.TableName})
+		}
+		layers[len(layers)-1].Columns = append(layers[len(layers)-1].Columns, row.ColumnName)
+	}
+	return layers, nil
+}
+
+// pickLayer returns the layer an admin level is built from. GADM ships a layer per
+// level, whose deepest GID is the level; the single-layer downloads hold the finest
+// areas only, which are dissolved into the level.
+func pickLayer(layers []stagedLayer, level int32) (stagedLayer, bool) {
+	var finest stagedLayer
+	found := false
+	for _, layer := range layers {
+		if layer.maxLevel() == level {
+			return layer, true
+		}
+		if layer.maxLevel() > level && (!found || layer.maxLevel() > finest.maxLevel()) {
+			finest, found = layer, true
+		}
+	}
+	return finest, found
+}
+
+// tableName qualifies the table of a level with the dataset schema
+func tableName(dataset string, level int32) string {
+	name := "admin" + strconv.Itoa(int(level))
+	if dataset == "" {
+		return name
+	}
+	return dataset + "." + name
+}
+
+// buildSQL creates the new table of a level from a staged layer with the columns of
+// models.AdminArea0 to AdminArea4, plus the country and the names of the parents the
+// address queries read. Geometries are made valid so ST_Contains never fails on them.
+func buildSQL(layer stagedLayer, level int32, table string) (string, error) {
+	country := "country"
+	if !slices.Contains(layer.Columns, "country") {
+		// GADM before 4.0 names the country name_0
+		if !slices.Contains(layer.Columns, "name_0") {
+			return "", fmt.Errorf("layer %s has no country name", layer.Name)
+		}
+		country = "name_0"
+	}
+
+	var groups []string
+	for n := int32(0); n <= level; n++ {
+		groups = append(groups, "gid_"+strconv.Itoa(int(n)))
+	}
+	groups = append(groups, country)
+	for n := int32(1); n <= level; n++ {
+		column := "name_" + strconv.Itoa(int(n))
+		if !slices.Contains(layer.Columns, column) {
+			return "", fmt.Errorf("layer %s has no %s column", layer.Name, column)
+		}
+		groups = append(groups, column)
+	}
+	columns := slices.Clone(groups)
+	columns[level+1] = country + " AS country"
+
+	source := stagingSchema + "." + layer.Name
+	if layer.maxLevel() == level {
+		return fmt.Sprintf(`CREATE TABLE %s AS
+SELECT ogc_fid, %s,
+	ST_Multi(ST_CollectionExtract(ST_MakeValid(geom), 3))::geometry(MultiPolygon, 4326) AS geom
+FROM %s`, table, strings.Join(columns, ", "), source), nil
+	}
+
+	// Dissolve the finer areas; areas without the level, e.g. in countries with fewer levels, are left out
+	gid := "gid_" + strconv.Itoa(int(level))
+	return fmt.Sprintf(`CREATE TABLE %s AS
+SELECT (row_number() OVER (ORDER BY %s))::integer AS ogc_fid, %s,
+	ST_Multi(ST_CollectionExtract(ST_MakeValid(ST_Union(geom)), 3))::geometry(MultiPolygon, 4326) AS geom
+FROM %s
+WHERE %s <> ''
+GROUP BY %s`, table, gid, strings.Join(columns, ", "), source, gid, strings.Join(groups, ", ")), nil
+}
+
+// build creates and indexes the new table of a level next to the live one
+func (i *GADMImporter) build(ctx context.Context, layer stagedLayer, level int32, dataset string) error {
+	table := tableName(dataset, level) + importSuffix
+	sql, err := buildSQL(layer, level, table)
+	if err != nil {
+		return err
+	}
+	indexName := "admin" + strconv.Itoa(int(level)) + importSuffix
+	nameColumn := "name_" + strconv.Itoa(int(level))
+	if level == 0 {
+		nameColumn = "country"
+	}
+	statements := []string{
+		"DROP TABLE IF EXISTS " + table,
+		sql,
+		"ALTER TABLE " + table + " ADD PRIMARY KEY (ogc_fid)",
+		"CREATE INDEX " + indexName + "_geom_idx ON " + table + " USING gist (geom)",
+		"CREATE INDEX " + indexName + "_gid_idx ON " + table + " (gid_" + strconv.Itoa(int(level)) + ")",
+		"CREATE INDEX " + indexName + "_name_trgm_idx ON " + table + " USING gin (" + nameColumn + " gin_trgm_ops)",
+		"ANALYZE " + table,
+	}
+	for _, statement := range statements {
+		if err := i.db.WithContext(ctx).Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// swap replaces the live tables with the new ones in a single transaction. Precomputed
+// simplifications and adjacency refer to the old ogc_fid values and are dropped.
+func (i *GADMImporter) swap(ctx context.Context, levels []int32, dataset string) error {
+	return i.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, level := range levels {
+			table := tableName(dataset, level)
+			name := "admin" + strconv.Itoa(int(level))
+			statements := []string{
+				"DROP TABLE IF EXISTS " + table,
+				"ALTER TABLE " + table + importSuffix + " RENAME TO " + name,
+			}
+			for _, suffix := range []string{"_geom_idx", "_gid_idx", "_name_trgm_idx"} {
+				statements = append(statements, fmt.Sprintf("ALTER INDEX %s RENAME TO %s",
+					qualify(dataset, name+importSuffix+suffix), name+suffix))
+			}
+			for _, statement := range statements {
+				if err := tx.Exec(statement).Error; err != nil {
+					return err
+				}
+			}
+
+			for _, precomputed := range []string{"admin_simplified", "admin_adjacency"} {
+				precomputed = qualify(dataset, precomputed)
+				var exists bool
+				if err := tx.Raw("SELECT to_regclass(?) IS NOT NULL", precomputed).Scan(&exists).Error; err != nil {
+					return err
+				}
+				if !exists {
+					continue
+				}
+				if err := tx.Exec("DELETE FROM "+precomputed+" WHERE admin_level = ?", level).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// qualify prefixes a table or index name with the dataset schema
+func qualify(dataset, name string) string {
+	if dataset == "" {
+		return name
+	}
+	return dataset + "." + name
+}
+
+// ParseLevels parses admin levels given as a range, e.g. "0-4", a list, e.g. "1,2",
+// or a mix of both
+func ParseLevels(s string) ([]int32, error) {
+	var levels []int32
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		first, last, isRange := strings.Cut(part, "-")
+		from, err := parseLevel(first)
+		if err != nil {
+			return nil, err
+		}
+		to := from
+		if isRange {
+			if to, err = parseLevel(last); err != nil {
+				return nil, err
+			}
+		}
+		if to < from {
+			return nil, fmt.Errorf("invalid admin level range %q", part)
+		}
+		for level := from; level <= to; level++ {
+			if !slices.Contains(levels, level) {
+				levels = append(levels, level)
+			}
+		}
+	}
+	slices.Sort(levels)
+	return levels, nil
+}
+
+func parseLevel(s string) (int32, error) {
+	level, err := strconv.ParseInt(strings.TrimSpace(s), 10, 32)
+	if err != nil || level < 0 || level > 4 {
+		return 0, fmt.Errorf("invalid admin level %q, must be between 0 and 4", s)
+	}
+	return int32(level), nil
+}
@@ -0,0 +1,120 @@
+package importer
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseLevels(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    []int32
+		wantErr bool
+	}{
+		{"0-4", []int32{0, 1, 2, 3, 4}, false},
+		{"2", []int32{2}, false},
+		{"3,1-2, 1", []int32{1, 2, 3}, false},
+		{"4-2", nil, true},
+		{"0-5", nil, true},
+		{"one", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLevels(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseLevels(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ParseLevels(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestSplitPassword(t *testing.T) {
+	tests := []struct {
+		dsn          string
+		wantConninfo string
+		wantPassword string
+		wantErr      bool
+	}{
+		{
+			"host=localhost user=postgres password=secret dbname=geojson port=5432 sslmode=disable",
+			"host='localhost' user='postgres' dbname='geojson' port='5432' sslmode='disable'", "secret", false,
+		},
+		{`host = db password='it\'s a secret' dbname='o\\brien'`, `host='db' dbname='o\\brien'`, "it's a secret", false},
+		{"host=db", "host='db'", "", false},
+		{
+			"postgres://postgres:s%40cret@db:5432/geojson?sslmode=require",
+			"host='db' port='5432' dbname='geojson' user='postgres' sslmode='require'", "s@cret", false,
+		},
+		{"host=db password='secret", "", "", true},
+		{"host", "", "", true},
+	}
+	for _, tt := range tests {
+		conninfo, password, err := splitPassword(tt.dsn)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("splitPassword(%q) error = %v, wantErr %v", tt.dsn, err, tt.wantErr)
+			continue
+		}
+		if conninfo != tt.wantConninfo || password != tt.wantPassword {
+			t.Errorf("splitPassword(%q) = %q, %q, want %q, %q", tt.dsn, conninfo, password, tt.wantConninfo, tt.wantPassword)
+		}
+	}
+}
+
+func TestPickLayer(t *testing.T) {
+	perLevel := []stagedLayer{
+		{Name: "adm_0", Columns: []string{"ogc_fid", "gid_0", "country", "geom"}},
+		{Name: "adm_1", Columns: []string{"ogc_fid", "gid_0", "country", "gid_1", "name_1", "geom"}},
+	}
+	if layer, ok := pickLayer(perLevel, 1); !ok || layer.Name != "adm_1" {
+		t.Errorf("pickLayer(1) = %s, %v, want adm_1", layer.Name, ok)
+	}
+	if _, ok := pickLayer(perLevel, 2); ok {
+		t.Error("pickLayer(2) found a layer in a file without level 2")
+	}
+
+	flat := []stagedLayer{{Name: "gadm_410", Columns: []string{"ogc_fid", "gid_0", "gid_1", "gid_2", "gid_3", "gid_4", "gid_5", "geom"}}}
+	if layer, ok := pickLayer(flat, 2); !ok || layer.Name != "gadm_410" {
+		t.Errorf("pickLayer(2) = %s, %v, want the flat gadm_410 layer", layer.Name, ok)
+	}
+}
+
+func TestBuildSQL(t *testing.T) {
+	// GADM 3.6 layers name the country name_0
+	layer := stagedLayer{Name: "level1", Columns: []string{"ogc_fid", "gid_0", "name_0", "gid_1", "name_1", "geom"}}
+	sql, err := buildSQL(layer, 1, "admin1_import")
+	if err != nil {
+		t.Fatalf("buildSQL() error = %v", err)
+	}
+	if !strings.Contains(sql, "SELECT ogc_fid, gid_0, gid_1, name_0 AS country, name_1,") {
+		t.Errorf("buildSQL() did not map name_0 to country:\n%s", sql)
+	}
+	if strings.Contains(sql, "GROUP BY") {
+		t.Errorf("buildSQL() dissolved a layer of the level itself:\n%s", sql)
+	}
+
+	flat := stagedLayer{Name: "gadm", Columns: []string{"ogc_fid", "gid_0", "country", "gid_1", "name_1", "gid_2", "name_2", "geom"}}
+	sql, err = buildSQL(flat, 1, "gadm41.admin1_import")
+	if err != nil {
+		t.Fatalf("buildSQL() error = %v", err)
+	}
+	if !strings.Contains(sql, "GROUP BY gid_0, gid_1, country, name_1") || !strings.Contains(sql, "WHERE gid_1 <> ''") {
+		t.Errorf("buildSQL() did not dissolve the flat layer:\n%s", sql)
+	}
+
+	if _, err := buildSQL(stagedLayer{Name: "x", Columns: []string{"gid_0", "gid_1", "country"}}, 1, "admin1_import"); err == nil {
+		t.Error("buildSQL() accepted a layer without name_1")
+	}
+}
+
+func TestImportWithoutOGR2OGR(t *testing.T) {
+	// The database is never reached, so none is needed
+	gadm := &GADMImporter{ogr2ogr: "gapi-missing-ogr2ogr"}
+	err := gadm.Import(context.Background(), GADMOptions{File: "gadm.gpkg", Levels: []int32{0}})
+	if err == nil || !strings.Contains(err.Error(), "install GDAL") {
+		t.Errorf("Import() error = %v, want a hint to install GDAL", err)
+	}
+}
@@ -1,8 +1,13 @@
 package infrastructure
 
 import (
+	"maps"
 	"os"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2/log"
 	"github.com/joho/godotenv"
@@ -15,8 +20,52 @@ type Config struct {
 	RedisURL    string
 	RedisPass   string
 	RedisDB     string
+
+	PrecomputeSimplified bool
+	StrictTolerance      bool
+	// AutoMigrate applies pending schema migrations at startup
+	AutoMigrate bool
+
+	// Datasets are the GADM releases loaded side by side, each in the schema of its name.
+	// DefaultDataset serves requests naming none; empty means the unqualified tables.
+	Datasets       []string
+	DefaultDataset string
+
+	// CacheWarm loads CacheWarmLevels in the geometry presets CacheWarmPresets into the
+	// cache in the background at startup, CacheWarmConcurrency requests at a time
+	CacheWarm            bool
+	CacheWarmLevels      string
+	CacheWarmPresets     string
+	CacheWarmConcurrency int
+
+	// CacheTTL is how long cached entries live, 0 for no expiry; CacheTTLs overrides it
+	// for keys starting with a prefix, the longest matching prefix winning
+	CacheTTL  time.Duration
+	CacheTTLs map[string]time.Duration
+	// CacheStaleTTL is how long expired entries are still served while they are
+	// recomputed, 0 disabling it
+	CacheStaleTTL time.Duration
+	// CacheLock makes a single instance recompute a missed entry, the others waiting
+	// for it in Redis
+	CacheLock bool
+
+	// CacheMemoryMB bounds the in-process cache in front of Redis, 0 disabling it.
+	// It also caches on its own when Redis is disabled.
+	CacheMemoryMB int
+
+	// AdminToken is the bearer token of the admin API; empty disables it
+	AdminToken string
 }
 
+// defaultCacheTTLs are the prefix TTLs CACHE_TTLS starts from: road searches follow the
+// live OSM data and popular ones are recomputed often, so they are kept briefly
+var defaultCacheTTLs = map[string]time.Duration{
+	"osm_line": 10 * time.Minute,
+}
+
+// datasetNamePattern restricts dataset names to plain schema identifiers
+var datasetNamePattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
 func LoadConfig() Config {
 	if err := godotenv.Load(); err != nil {
 		log.Warnf("Error loading .env file: %v", err)
@@ -43,6 +92,8 @@ func LoadConfig() Config {
 		}
 	}
 
+	datasets, defaultDataset := loadDatasets()
+
 	return Config{
 		DatabaseURL: os.Getenv("DATA_SOURCE_NAME"),
 		CorsOrigins: os.Getenv("CORS_ORIGINS"),
@@ -50,5 +101,128 @@ func LoadConfig() Config {
 		RedisURL:    redisURL,
 		RedisPass:   redisPass,
 		RedisDB:     redisDBStr,
+
+		PrecomputeSimplified: getEnvBool("PRECOMPUTE_SIMPLIFIED"),
+		StrictTolerance:      getEnvBool("STRICT_TOLERANCE"),
+		AutoMigrate:          getEnvBool("AUTO_MIGRATE"),
+
+		Datasets:       datasets,
+		DefaultDataset: defaultDataset,
+
+		CacheWarm:            getEnvBool("CACHE_WARM"),
+		CacheWarmLevels:      getEnvDefault("CACHE_WARM_LEVELS", "0-1"),
+		CacheWarmPresets:     getEnvDefault("CACHE_WARM_PRESETS", "full,nogeom"),
+		CacheWarmConcurrency: getEnvInt("CACHE_WARM_CONCURRENCY", 4, 1),
+
+		CacheTTL:  getEnvDuration("CACHE_TTL", 24*time.Hour),
+		CacheTTLs: loadCacheTTLs(),
+
+		CacheStaleTTL: getEnvDuration("CACHE_STALE_TTL", time.Hour),
+		CacheLock:     getEnvBool("CACHE_LOCK"),
+
+		CacheMemoryMB: getEnvInt("CACHE_MEMORY_MB", 64, 0),
+
+		AdminToken: os.Getenv("ADMIN_TOKEN"),
+	}
+}
+
+// ValidDatasetName reports whether a dataset name can be used as a schema name
+func ValidDatasetName(name string) bool {
+	return datasetNamePattern.MatchString(name)
+}
+
+// loadDatasets reads GADM_DATASETS, a comma-separated list of dataset names, and
+// GADM_DEFAULT_DATASET, which is added to the list when missing from it
+func loadDatasets() (datasets []string, defaultDataset string) {
+	for _, name := range strings.Split(os.Getenv("GADM_DATASETS"), ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !ValidDatasetName(name) {
+			log.Warnf("Invalid dataset name %q in GADM_DATASETS, ignoring it", name)
+			continue
+		}
+		datasets = append(datasets, name)
+	}
+
+	defaultDataset = strings.TrimSpace(os.Getenv("GADM_DEFAULT_DATASET"))
+	if defaultDataset != "" && !ValidDatasetName(defaultDataset) {
+		log.Warnf("Invalid GADM_DEFAULT_DATASET=%q, using the unqualified tables", defaultDataset)
+		defaultDataset = ""
+	}
+	if defaultDataset != "" && !slices.Contains(datasets, defaultDataset) {
+		datasets = append(datasets, defaultDataset)
+	}
+	return datasets, defaultDataset
+}
+
+// loadCacheTTLs reads CACHE_TTLS, a comma-separated list of prefix=duration pairs,
+// e.g. "admin_area=168h,export:=1h", over defaultCacheTTLs
+func loadCacheTTLs() map[string]time.Duration {
+	ttls := maps.Clone(defaultCacheTTLs)
+	for _, pair := range strings.Split(os.Getenv("CACHE_TTLS"), ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		prefix, value, ok := strings.Cut(pair, "=")
+		ttl, err := time.ParseDuration(strings.TrimSpace(value))
+		if !ok || strings.TrimSpace(prefix) == "" || err != nil || ttl < 0 {
+			log.Warnf("Invalid cache TTL %q in CACHE_TTLS, ignoring it", pair)
+			continue
+		}
+		ttls[strings.TrimSpace(prefix)] = ttl
+	}
+	return ttls
+}
+
+// getEnvBool parses a boolean environment variable, treating unset or invalid values as false
+func getEnvBool(key string) bool {
+	v := os.Getenv(key)
+	if v == "" {
+		return false
+	}
+	parsed, err := strconv.ParseBool(v)
+	if err != nil {
+		log.Warnf("Invalid %s=%q, defaulting to false: %v", key, v, err)
+		return false
+	}
+	return parsed
+}
+
+// getEnvDefault returns an environment variable, or fallback when it is unset or empty
+func getEnvDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// getEnvInt parses an integer environment variable of at least minimum, treating unset or invalid values as fallback
+func getEnvInt(key string, fallback, minimum int) int {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(v)
+	if err != nil || parsed < minimum {
+		log.Warnf("Invalid %s=%q, defaulting to %d", key, v, fallback)
+		return fallback
+	}
+	return parsed
+}
+
+// getEnvDuration parses a duration environment variable such as "30m", treating unset or invalid values as fallback
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	v := os.Getenv(key)
+	if v == "" {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(v)
+	if err != nil || parsed < 0 {
+		log.Warnf("Invalid %s=%q, defaulting to %s", key, v, fallback)
+		return fallback
 	}
+	return parsed
 }
@@ -0,0 +1,105 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/gofiber/fiber/v2/log"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+// geofenceEventChannel is the Redis pub/sub channel geofence events are fanned out on
+const geofenceEventChannel = "gapi:geofence-events"
+
+// eventBusBuffer is how many events a subscriber may fall behind before the bus blocks or drops
+const eventBusBuffer = 256
+
+// EventBus delivers geofence events to every subscribed instance. With Redis it uses
+// pub/sub so devices may report to any instance; without Redis events stay in this process.
+type EventBus struct {
+	client *redis.Client
+
+	mu    sync.Mutex
+	local map[chan *domain.GeofenceEvent]struct{}
+}
+
+func NewEventBus(client *redis.Client) *EventBus {
+	return &EventBus{client: client, local: make(map[chan *domain.GeofenceEvent]struct{})}
+}
+
+// Publish sends the event to all subscribers, including those of this instance
+func (b *EventBus) Publish(ctx context.Context, event *domain.GeofenceEvent) error {
+	if b.client == nil {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		for ch := range b.local {
+			select {
+			case ch <- event:
+			default:
+				log.Errorf("Dropping geofence event for device %s, subscriber is behind", event.DeviceID)
+			}
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, geofenceEventChannel, data).Err()
+}
+
+// Subscribe returns the events published from now on; the channel is closed when ctx is done
+func (b *EventBus) Subscribe(ctx context.Context) (<-chan *domain.GeofenceEvent, error) {
+	ch := make(chan *domain.GeofenceEvent, eventBusBuffer)
+
+	if b.client == nil {
+		b.mu.Lock()
+		b.local[ch] = struct{}{}
+		b.mu.Unlock()
+		go func() {
+			<-ctx.Done()
+			b.mu.Lock()
+			delete(b.local, ch)
+			close(ch)
+			b.mu.Unlock()
+		}()
+		return ch, nil
+	}
+
+	pubsub := b.client.Subscribe(ctx, geofenceEventChannel)
+	// Wait for the subscription to be confirmed so no event published afterwards is missed
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+
+	go func() {
+		defer close(ch)
+		defer pubsub.Close()
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var event domain.GeofenceEvent
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					log.Errorf("Failed to decode geofence event: %v", err)
+					continue
+				}
+				select {
+				case ch <- &event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
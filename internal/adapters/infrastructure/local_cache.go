@@ -0,0 +1,213 @@
+package infrastructure
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// localEntryOverhead approximates the bookkeeping of an entry beyond its key and encoded value
+const localEntryOverhead = 128
+
+// localCache is an in-process LRU of decoded values bounded by their encoded size.
+// Values are shared between the requests reading them and must not be modified.
+type localCache struct {
+	mu       sync.Mutex
+	maxBytes int
+	size     int
+	order    *list.List // front is the most recently used
+	entries  map[string]*list.Element
+}
+
+type localEntry struct {
+	key     string
+	value   any
+	size    int
+	staleAt time.Time // zero for never
+	expires time.Time // zero for no expiry
+}
+
+// newLocalCache returns a cache holding up to maxBytes, or nil when maxBytes is 0
+func newLocalCache(maxBytes int) *localCache {
+	if maxBytes <= 0 {
+		return nil
+	}
+	return &localCache{maxBytes: maxBytes, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+// lookup copies the value of key into dest, a pointer to the value's type or to the
+// type it points to, and reports whether it was found and whether it is stale
+func (l *localCache) lookup(key string, dest any) (found, stale bool) {
+	if l == nil {
+		return false, false
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.entries[key]
+	if !ok {
+		return false, false
+	}
+	entry := element.Value.(*localEntry)
+	now := time.Now()
+	if !entry.expires.IsZero() && now.After(entry.expires) {
+		l.remove(element)
+		return false, false
+	}
+	if !assign(dest, entry.value) {
+		return false, false
+	}
+	l.order.MoveToFront(element)
+	return true, !entry.staleAt.IsZero() && now.After(entry.staleAt)
+}
+
+// add stores the value of key, weighing encodedSize bytes, evicting the least recently
+// used entries to make room. Values larger than the whole cache are not stored.
+func (l *localCache) add(key string, value any, encodedSize int, staleAt, expires time.Time) {
+	if l == nil || value == nil {
+		return
+	}
+	size := len(key) + encodedSize + localEntryOverhead
+	if size > l.maxBytes {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if element, ok := l.entries[key]; ok {
+		l.remove(element)
+	}
+	l.entries[key] = l.order.PushFront(&localEntry{key: key, value: value, size: size, staleAt: staleAt, expires: expires})
+	l.size += size
+	for l.size > l.maxBytes {
+		l.remove(l.order.Back())
+	}
+}
+
+// delete removes key
+func (l *localCache) delete(key string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if element, ok := l.entries[key]; ok {
+		l.remove(element)
+	}
+}
+
+// deletePattern removes the keys matching a Redis glob pattern
+func (l *localCache) deletePattern(pattern string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, element := range l.entries {
+		if matchPattern(pattern, key) {
+			l.remove(element)
+		}
+	}
+}
+
+func (l *localCache) remove(element *list.Element) {
+	entry := l.order.Remove(element).(*localEntry)
+	delete(l.entries, entry.key)
+	l.size -= entry.size
+}
+
+// assign sets *dest to value, or to *value when value points to dest's type
+func assign(dest, value any) bool {
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return false
+	}
+	target = target.Elem()
+	v := reflect.ValueOf(value)
+	switch {
+	case v.Type().AssignableTo(target.Type()):
+		target.Set(v)
+	case v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().Type().AssignableTo(target.Type()):
+		target.Set(v.Elem())
+	default:
+		return false
+	}
+	return true
+}
+
+// matchPattern reports whether key matches a Redis glob pattern: * matches any run of
+// characters, ? any one character, [abc] and [a-z] a set, and \ escapes the next character
+func matchPattern(pattern, key string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 0 && pattern[0] == '*' {
+				pattern = pattern[1:]
+			}
+			if pattern == "" {
+				return true
+			}
+			for i := 0; i <= len(key); i++ {
+				if matchPattern(pattern, key[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if key == "" {
+				return false
+			}
+			pattern, key = pattern[1:], key[1:]
+		case '[':
+			if key == "" {
+				return false
+			}
+			end := 1
+			for end < len(pattern) && pattern[end] != ']' {
+				if pattern[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(pattern) || !matchSet(pattern[1:end], key[0]) {
+				return false
+			}
+			pattern, key = pattern[end+1:], key[1:]
+		default:
+			if pattern[0] == '\\' && len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			if key == "" || pattern[0] != key[0] {
+				return false
+			}
+			pattern, key = pattern[1:], key[1:]
+		}
+	}
+	return key == ""
+}
+
+// matchSet matches a character against the inside of a [set], ^ negating it
+func matchSet(set string, c byte) bool {
+	negate := len(set) > 0 && set[0] == '^'
+	if negate {
+		set = set[1:]
+	}
+	matched := false
+	for i := 0; i < len(set); i++ {
+		lo := set[i]
+		if lo == '\\' && i+1 < len(set) {
+			i++
+			lo = set[i]
+		}
+		hi := lo
+		if i+2 < len(set) && set[i+1] == '-' {
+			hi = set[i+2]
+			i += 2
+		}
+		if lo <= c && c <= hi {
+			matched = true
+		}
+	}
+	return matched != negate
+}
@@ -0,0 +1,159 @@
+package infrastructure
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocalCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	// Room for two entries of 100 encoded bytes
+	cache := newLocalCache(2 * (100 + 1 + localEntryOverhead))
+	cache.add("a", 1, 100, time.Time{}, time.Time{})
+	cache.add("b", 2, 100, time.Time{}, time.Time{})
+
+	var got int
+	cache.get("a", &got)
+	cache.add("c", 3, 100, time.Time{}, time.Time{})
+
+	if !cache.get("a", &got) || got != 1 {
+		t.Errorf("recently used entry a was evicted")
+	}
+	if cache.get("b", &got) {
+		t.Errorf("least recently used entry b was kept")
+	}
+	if !cache.get("c", &got) || got != 3 {
+		t.Errorf("new entry c is missing")
+	}
+}
+
+func TestLocalCacheSkipsOversizedValues(t *testing.T) {
+	cache := newLocalCache(1000)
+	cache.add("small", 1, 10, time.Time{}, time.Time{})
+	cache.add("large", 2, 1000, time.Time{}, time.Time{})
+
+	var got int
+	if cache.get("large", &got) {
+		t.Errorf("value larger than the cache was stored")
+	}
+	if !cache.get("small", &got) {
+		t.Errorf("oversized value evicted the others")
+	}
+}
+
+func TestLocalCacheExpires(t *testing.T) {
+	cache := newLocalCache(1 << 20)
+	cache.add("short", 1, 10, time.Time{}, time.Now().Add(time.Millisecond))
+	cache.add("forever", 2, 10, time.Time{}, time.Time{})
+	time.Sleep(5 * time.Millisecond)
+
+	var got int
+	if cache.get("short", &got) {
+		t.Errorf("expired entry was returned")
+	}
+	if !cache.get("forever", &got) {
+		t.Errorf("entry without TTL expired")
+	}
+	if cache.size != len("forever")+10+localEntryOverhead {
+		t.Errorf("size = %d after expiry, want only the remaining entry", cache.size)
+	}
+}
+
+func TestLocalCacheReportsStaleEntries(t *testing.T) {
+	cache := newLocalCache(1 << 20)
+	cache.add("stale", 1, 10, time.Now().Add(-time.Second), time.Now().Add(time.Hour))
+	cache.add("fresh", 2, 10, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour))
+
+	var got int
+	if found, stale := cache.lookup("stale", &got); !found || !stale {
+		t.Errorf("lookup(stale) = %v, %v, want found and stale", found, stale)
+	}
+	if found, stale := cache.lookup("fresh", &got); !found || stale {
+		t.Errorf("lookup(fresh) = %v, %v, want found and fresh", found, stale)
+	}
+}
+
+func TestLocalCacheAssignsPointedValues(t *testing.T) {
+	type area struct{ Name string }
+	cache := newLocalCache(1 << 20)
+	cache.add("pointer", &area{Name: "Thailand"}, 10, time.Time{}, time.Time{})
+	cache.add("value", []string{"THA"}, 10, time.Time{}, time.Time{})
+
+	var a area
+	if !cache.get("pointer", &a) || a.Name != "Thailand" {
+		t.Errorf("get(pointer) = %+v, want the pointed value", a)
+	}
+	var codes []string
+	if !cache.get("value", &codes) || len(codes) != 1 {
+		t.Errorf("get(value) = %v, want [THA]", codes)
+	}
+	var wrong int
+	if cache.get("value", &wrong) {
+		t.Errorf("get into a different type succeeded")
+	}
+}
+
+func TestLocalCacheDeletePattern(t *testing.T) {
+	cache := newLocalCache(1 << 20)
+	for _, key := range []string{"admin_area:list:1:full", "admin_area:list:1:full|v2", "admin_area:list:2:full", "cells:grid:1:THA:5"} {
+		cache.add(key, 1, 10, time.Time{}, time.Time{})
+	}
+
+	cache.deletePattern("admin_area:list:1:*")
+
+	var got int
+	for key, want := range map[string]bool{
+		"admin_area:list:1:full":    false,
+		"admin_area:list:1:full|v2": false,
+		"admin_area:list:2:full":    true,
+		"cells:grid:1:THA:5":        true,
+	} {
+		if cache.get(key, &got) != want {
+			t.Errorf("after deletePattern, found %q = %v, want %v", key, !want, want)
+		}
+	}
+}
+
+func TestNilLocalCache(t *testing.T) {
+	cache := newLocalCache(0)
+	cache.add("a", 1, 10, time.Time{}, time.Time{})
+	cache.delete("a")
+	cache.deletePattern("*")
+
+	var got int
+	if cache.get("a", &got) {
+		t.Errorf("disabled cache returned an entry")
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		key     string
+		want    bool
+	}{
+		{pattern: "admin_area*", key: "admin_area:list:1", want: true},
+		{pattern: "admin_area*", key: "cells:grid", want: false},
+		{pattern: "admin_area:?:x", key: "admin_area:1:x", want: true},
+		{pattern: "admin_area:?:x", key: "admin_area:12:x", want: false},
+		{pattern: "level:[0-2]", key: "level:1", want: true},
+		{pattern: "level:[^0-2]", key: "level:1", want: false},
+		{pattern: "level:[13]", key: "level:3", want: true},
+		{pattern: `code:THA.10\_1`, key: "code:THA.10_1", want: true},
+		{pattern: `opts:\[1\]*`, key: "opts:[1] 10", want: true},
+		{pattern: `opts:\*`, key: "opts:x", want: false},
+		{pattern: "a*b*c", key: "axxbyyc", want: true},
+		{pattern: "a*b*c", key: "axxbyy", want: false},
+		{pattern: "", key: "", want: true},
+	}
+	for _, tt := range tests {
+		if got := matchPattern(tt.pattern, tt.key); got != tt.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", tt.pattern, tt.key, got, tt.want)
+		}
+	}
+}
+
+// get reports whether key is cached, stale or not
+func (l *localCache) get(key string, dest any) bool {
+	found, _ := l.lookup(key, dest)
+	return found
+}
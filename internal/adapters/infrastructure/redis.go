@@ -2,10 +2,18 @@ package infrastructure
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2/log"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
 	"github.com/klauspost/compress/s2"
 	"github.com/redis/go-redis/v9"
 	"github.com/vmihailenco/msgpack/v5"
@@ -47,41 +55,141 @@ func ConnectRedis(cfg Config) *redis.Client {
 	return client
 }
 
+// CachePatterns match every key gapi caches. Other keys of a shared Redis database are left alone.
+var CachePatterns = func() []string {
+	patterns := make([]string, len(domain.CacheKeyPrefixes))
+	for i, prefix := range domain.CacheKeyPrefixes {
+		patterns[i] = prefix + "*"
+	}
+	return patterns
+}()
+
+const (
+	// versionKey holds the namespace version of a dataset's cached entries, suffixed
+	// with ":<dataset>" for the dataset schemas
+	versionKey = "cache_version"
+	// versionRefreshInterval is how long a dataset version is used before it is read
+	// again, and so how long other instances keep serving a renewed namespace if they
+	// miss its invalidation message
+	versionRefreshInterval = 5 * time.Second
+	// invalidationChannel carries the deletions and namespace renewals of an instance to
+	// the in-process caches of the others
+	invalidationChannel = "gapi:cache:invalidate"
+	// localMaxAge bounds how long an entry read from Redis is kept in process, so that an
+	// instance missing an invalidation message does not serve a deleted entry for long
+	localMaxAge = 10 * time.Minute
+	// lockPrefix prefixes the locks of the keys being recomputed, outside CachePatterns
+	lockPrefix = "lock:"
+	// lockTTL bounds how long an instance may hold a lock, and others wait for its entry
+	lockTTL = 30 * time.Second
+	// lockPollInterval is how often waiting instances look for the entry of a locked key
+	lockPollInterval = 100 * time.Millisecond
+)
+
+// unlockScript releases a lock only if it still holds the token of its owner
+var unlockScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
 type Cache struct {
-	client *redis.Client
+	client         *redis.Client
+	local          *localCache
+	instance       string
+	ttl            time.Duration
+	ttls           map[string]time.Duration
+	staleTTL       time.Duration
+	locking        bool
+	defaultDataset string
+
+	mu       sync.Mutex
+	versions map[string]cachedVersion
 }
 
-func NewCache(client *redis.Client) *Cache {
-	return &Cache{client: client}
+type cachedVersion struct {
+	value   int64
+	fetched time.Time
 }
 
+// invalidation is a message of invalidationChannel: a key or pattern to delete, or the
+// new namespace version of a dataset
+type invalidation struct {
+	Instance string `json:"instance"`
+	Key      string `json:"key,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+	Dataset  string `json:"dataset,omitempty"`
+	Version  int64  `json:"version,omitempty"`
+}
+
+// NewCache stores entries in Redis with the TTLs of the configuration, followed by the
+// stale window in which Lookup still returns them, keeping up to
+// CacheMemoryMB of them decoded in process in front of it, or on their own when Redis
+// is disabled. Keys are namespaced with the version of the request's dataset, see
+// RenewNamespace.
+func NewCache(client *redis.Client, cfg Config) *Cache {
+	return &Cache{
+		client:         client,
+		local:          newLocalCache(cfg.CacheMemoryMB << 20),
+		instance:       newInstanceID(),
+		ttl:            cfg.CacheTTL,
+		ttls:           cfg.CacheTTLs,
+		staleTTL:       cfg.CacheStaleTTL,
+		locking:        cfg.CacheLock,
+		defaultDataset: cfg.DefaultDataset,
+		versions:       make(map[string]cachedVersion),
+	}
+}
+
+// Get decodes the fresh entry of key into dest. Entries served in process are shared
+// between requests, so the values read must not be modified.
 func (c *Cache) Get(ctx context.Context, key string, dest interface{}) bool {
+	found, stale := c.Lookup(ctx, key, dest)
+	return found && !stale
+}
+
+// Lookup decodes the entry of key into dest like Get, and also returns entries past
+// their TTL but within the stale window, reporting them as stale so that the caller
+// can serve them while it refreshes them
+func (c *Cache) Lookup(ctx context.Context, key string, dest interface{}) (found, stale bool) {
+	namespaced := c.namespaced(ctx, key)
+	if found, stale := c.local.lookup(namespaced, dest); found {
+		return found, stale
+	}
 	if c.client == nil {
-		return false
+		return false, false
 	}
 
-	// Get compressed data from Redis
-	compressed, err := c.client.Get(ctx, key).Bytes()
+	// Get compressed data from Redis, with the time it has left to live
+	pipe := c.client.Pipeline()
+	get := pipe.Get(ctx, namespaced)
+	pttl := pipe.PTTL(ctx, namespaced)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, false
+	}
+	compressed, err := get.Bytes()
 	if err != nil {
-		return false
+		return false, false
 	}
 
 	// Decompress using S2
 	data, err := s2.Decode(nil, compressed)
 	if err != nil {
-		return false
+		return false, false
 	}
 
 	// Unmarshal using MessagePack
 	if err := msgpack.Unmarshal(data, dest); err != nil {
-		return false
+		return false, false
 	}
 
-	return true
+	staleAt, expires := c.localTimes(pttl.Val())
+	c.local.add(namespaced, reflect.ValueOf(dest).Elem().Interface(), len(data), staleAt, expires)
+	return true, !staleAt.IsZero() && !time.Now().Before(staleAt)
 }
 
 func (c *Cache) Set(ctx context.Context, key string, value interface{}) {
-	if c.client == nil {
+	if c.client == nil && c.local == nil {
 		return
 	}
 
@@ -92,24 +200,85 @@ func (c *Cache) Set(ctx context.Context, key string, value interface{}) {
 		return
 	}
 
+	namespaced := c.namespaced(ctx, key)
+	expiry := c.expiry(key)
+	staleAt, expires := c.localTimes(expiry)
+	c.local.add(namespaced, value, len(data), staleAt, expires)
+	if c.client == nil {
+		return
+	}
+
 	// Compress using S2
 	compressed := s2.Encode(nil, data)
 
-	if err := c.client.Set(ctx, key, compressed, 0).Err(); err != nil {
+	if err := c.client.Set(ctx, namespaced, compressed, expiry).Err(); err != nil {
 		log.Errorf("Failed to set cache: %v", err)
 	}
 }
 
+// Lock takes the distributed lock of key before recomputing its entry, when CACHE_LOCK
+// enables them, and reports whether it was acquired; unlock releases it. Without locks,
+// or when Redis fails, it is always acquired. Locks of crashed instances expire after lockTTL.
+func (c *Cache) Lock(ctx context.Context, key string) (unlock func(), acquired bool) {
+	if c.client == nil || !c.locking {
+		return func() {}, true
+	}
+	lockKey := lockPrefix + c.namespaced(ctx, key)
+	token := newInstanceID()
+	acquired, err := c.client.SetNX(ctx, lockKey, token, lockTTL).Result()
+	if err != nil {
+		log.Errorf("Failed to lock %s: %v", key, err)
+		return func() {}, true
+	}
+	if !acquired {
+		return nil, false
+	}
+	return func() {
+		if err := unlockScript.Run(context.WithoutCancel(ctx), c.client, []string{lockKey}, token).Err(); err != nil && !errors.Is(err, redis.Nil) {
+			log.Errorf("Failed to unlock %s: %v", key, err)
+		}
+	}, true
+}
+
+// Await waits for the instance holding the lock of key to store its entry, decoding it
+// into dest. It gives up when the lock is released or expires without an entry.
+func (c *Cache) Await(ctx context.Context, key string, dest interface{}) bool {
+	lockKey := lockPrefix + c.namespaced(ctx, key)
+	ticker := time.NewTicker(lockPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+		if c.Get(ctx, key, dest) {
+			return true
+		}
+		if locked, err := c.client.Exists(ctx, lockKey).Result(); err != nil || locked == 0 {
+			// The entry may have been stored just before the lock was released
+			return c.Get(ctx, key, dest)
+		}
+	}
+}
+
 // Delete removes a key from cache
 func (c *Cache) Delete(ctx context.Context, key string) error {
+	namespaced := c.namespaced(ctx, key)
+	c.local.delete(namespaced)
 	if c.client == nil {
 		return nil
 	}
-	return c.client.Del(ctx, key).Err()
+	if err := c.client.Del(ctx, namespaced).Err(); err != nil {
+		return err
+	}
+	c.publish(ctx, invalidation{Key: namespaced})
+	return nil
 }
 
 // DeletePattern removes all keys matching a pattern
 func (c *Cache) DeletePattern(ctx context.Context, pattern string) error {
+	c.local.deletePattern(pattern)
 	if c.client == nil {
 		return nil
 	}
@@ -120,14 +289,201 @@ func (c *Cache) DeletePattern(ctx context.Context, pattern string) error {
 			log.Errorf("Failed to delete key %s: %v", iter.Val(), err)
 		}
 	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
 
-	return iter.Err()
+	c.publish(ctx, invalidation{Pattern: pattern})
+	return nil
 }
 
-// Clear removes all keys from the cache
+// Clear removes every key gapi caches, leaving the namespace versions and unrelated keys
 func (c *Cache) Clear(ctx context.Context) error {
+	for _, pattern := range CachePatterns {
+		if err := c.DeletePattern(ctx, pattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run applies the invalidations published by the other instances to this one until
+// ctx is done. Without Redis there are no other instances and it returns at once.
+func (c *Cache) Run(ctx context.Context) error {
 	if c.client == nil {
 		return nil
 	}
-	return c.client.FlushDB(ctx).Err()
+	sub := c.client.Subscribe(ctx, invalidationChannel)
+	defer sub.Close()
+	if _, err := sub.Receive(ctx); err != nil {
+		return err
+	}
+
+	messages := sub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			var inv invalidation
+			if err := json.Unmarshal([]byte(msg.Payload), &inv); err != nil {
+				log.Errorf("Invalid cache invalidation %q: %v", msg.Payload, err)
+				continue
+			}
+			c.apply(inv)
+		}
+	}
+}
+
+func (c *Cache) apply(inv invalidation) {
+	if inv.Instance == c.instance {
+		return
+	}
+	switch {
+	case inv.Key != "":
+		c.local.delete(inv.Key)
+	case inv.Pattern != "":
+		c.local.deletePattern(inv.Pattern)
+	case inv.Version > 0:
+		// Messages may overtake a read of the version: never go back to an older one
+		c.mu.Lock()
+		if inv.Version > c.versions[inv.Dataset].value {
+			c.versions[inv.Dataset] = cachedVersion{value: inv.Version, fetched: time.Now()}
+		}
+		c.mu.Unlock()
+	}
+}
+
+func (c *Cache) publish(ctx context.Context, inv invalidation) {
+	inv.Instance = c.instance
+	payload, err := json.Marshal(inv)
+	if err != nil {
+		log.Errorf("Failed to encode cache invalidation: %v", err)
+		return
+	}
+	if err := c.client.Publish(ctx, invalidationChannel, payload).Err(); err != nil {
+		log.Errorf("Failed to publish cache invalidation: %v", err)
+	}
+}
+
+// expiry returns how long the entry of a key is stored: its TTL, then the stale window.
+// 0 means no expiry.
+func (c *Cache) expiry(key string) time.Duration {
+	ttl := c.TTL(key)
+	if ttl > 0 {
+		ttl += c.staleTTL
+	}
+	return ttl
+}
+
+// localTimes returns when an entry with remaining time to live, not positive for no
+// expiry, becomes stale and expires in process. Entries Redis holds too are kept
+// localMaxAge at most.
+func (c *Cache) localTimes(remaining time.Duration) (staleAt, expires time.Time) {
+	now := time.Now()
+	if remaining > 0 {
+		staleAt = now.Add(remaining - c.staleTTL)
+		expires = now.Add(remaining)
+	}
+	if maxExpires := now.Add(localMaxAge); c.client != nil && (expires.IsZero() || expires.After(maxExpires)) {
+		expires = maxExpires
+	}
+	return staleAt, expires
+}
+
+// TTL returns how long the entry of a key lives: the TTL of the longest configured
+// prefix of the key, or the default. 0 means no expiry.
+func (c *Cache) TTL(key string) time.Duration {
+	ttl, longest := c.ttl, -1
+	for prefix, prefixTTL := range c.ttls {
+		if len(prefix) > longest && strings.HasPrefix(key, prefix) {
+			ttl, longest = prefixTTL, len(prefix)
+		}
+	}
+	return ttl
+}
+
+// Version returns the namespace version of the request's dataset, 0 until it is first renewed
+func (c *Cache) Version(ctx context.Context) int64 {
+	dataset := c.dataset(ctx)
+
+	c.mu.Lock()
+	cached, ok := c.versions[dataset]
+	c.mu.Unlock()
+	// Without Redis the versions only live in process
+	if c.client == nil || ok && time.Since(cached.fetched) < versionRefreshInterval {
+		return cached.value
+	}
+
+	version, err := c.client.Get(ctx, versionKeyOf(dataset)).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		// Keep the last known version rather than reading a stale namespace
+		log.Errorf("Failed to read cache version of %q: %v", dataset, err)
+		return cached.value
+	}
+	c.storeVersion(dataset, version)
+	return version
+}
+
+// RenewNamespace moves the request's dataset to a new namespace version, so every entry
+// cached for it is missed at once. The old entries are left to expire.
+func (c *Cache) RenewNamespace(ctx context.Context) (int64, error) {
+	dataset := c.dataset(ctx)
+	if c.client == nil {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		version := c.versions[dataset].value + 1
+		c.versions[dataset] = cachedVersion{value: version, fetched: time.Now()}
+		return version, nil
+	}
+
+	version, err := c.client.Incr(ctx, versionKeyOf(dataset)).Result()
+	if err != nil {
+		return 0, err
+	}
+	c.storeVersion(dataset, version)
+	c.publish(ctx, invalidation{Dataset: dataset, Version: version})
+	return version, nil
+}
+
+func (c *Cache) storeVersion(dataset string, version int64) {
+	c.mu.Lock()
+	c.versions[dataset] = cachedVersion{value: version, fetched: time.Now()}
+	c.mu.Unlock()
+}
+
+// dataset resolves the request's dataset, "" selecting the default one
+func (c *Cache) dataset(ctx context.Context) string {
+	if dataset := domain.DatasetFromContext(ctx); dataset != "" {
+		return dataset
+	}
+	return c.defaultDataset
+}
+
+// namespaced suffixes a key with the namespace version of the request's dataset. Keys
+// keep their prefix so that patterns and TTLs still apply; version 0 adds nothing.
+func (c *Cache) namespaced(ctx context.Context, key string) string {
+	if version := c.Version(ctx); version > 0 {
+		return key + "|v" + strconv.FormatInt(version, 10)
+	}
+	return key
+}
+
+func versionKeyOf(dataset string) string {
+	if dataset == "" {
+		return versionKey
+	}
+	return versionKey + ":" + dataset
+}
+
+// newInstanceID identifies the invalidation messages of this process
+func newInstanceID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
 }
@@ -0,0 +1,120 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestCacheTTL(t *testing.T) {
+	cache := NewCache(nil, Config{
+		CacheTTL: 24 * time.Hour,
+		CacheTTLs: map[string]time.Duration{
+			"admin_area":      168 * time.Hour,
+			"admin_area:list": time.Hour,
+			"export:":         0,
+		},
+	})
+
+	tests := []struct {
+		key  string
+		want time.Duration
+	}{
+		{key: "admin_area:code:1:THA.10_1:<nil>", want: 168 * time.Hour},
+		{key: "admin_area:list@gadm41:1:nogeom", want: time.Hour},
+		{key: "export:topojson:1:*:STANDARD:<nil>:10000", want: 0},
+		{key: "cells:GEOHASH:1:THA.10_1:5", want: 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := cache.TTL(tt.key); got != tt.want {
+			t.Errorf("TTL(%q) = %s, want %s", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestLoadCacheTTLs(t *testing.T) {
+	t.Setenv("CACHE_TTLS", "admin_area=168h, export:=30m,cells=soon,=1h")
+
+	ttls := loadCacheTTLs()
+
+	if len(ttls) != 3 || ttls["admin_area"] != 168*time.Hour || ttls["export:"] != 30*time.Minute || ttls["osm_line"] != 10*time.Minute {
+		t.Errorf("loadCacheTTLs() = %v, want admin_area, export: and the default osm_line only", ttls)
+	}
+}
+
+func TestLoadCacheTTLsOverridesDefaults(t *testing.T) {
+	t.Setenv("CACHE_TTLS", "osm_line=1m")
+
+	ttls := loadCacheTTLs()
+
+	if ttls["osm_line"] != time.Minute || defaultCacheTTLs["osm_line"] != 10*time.Minute {
+		t.Errorf("loadCacheTTLs() = %v, want osm_line overridden without changing the defaults", ttls)
+	}
+}
+
+func TestCacheWithoutRedis(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(nil, Config{CacheTTL: time.Hour, CacheMemoryMB: 1})
+	cache.Set(ctx, "admin_area:list:1:full", []string{"THA.10_1"})
+	cache.Set(ctx, "cells:grid:1:THA:5", []string{"w4rq"})
+
+	var got []string
+	if !cache.Get(ctx, "admin_area:list:1:full", &got) || len(got) != 1 {
+		t.Fatalf("Get() = %v, want the entry set in process", got)
+	}
+
+	if err := cache.DeletePattern(ctx, "admin_area*"); err != nil {
+		t.Fatal(err)
+	}
+	if cache.Get(ctx, "admin_area:list:1:full", &got) {
+		t.Errorf("entry matching the deleted pattern was returned")
+	}
+
+	version, err := cache.RenewNamespace(ctx)
+	if err != nil || version != 1 || cache.Version(ctx) != 1 {
+		t.Errorf("RenewNamespace() = %d, %v, want version 1", version, err)
+	}
+	if cache.Get(ctx, "cells:grid:1:THA:5", &got) {
+		t.Errorf("entry of the renewed namespace was returned")
+	}
+}
+
+func TestCacheAppliesInvalidations(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(nil, Config{CacheMemoryMB: 1})
+	cache.Set(ctx, "admin_area:code:1:THA.10_1:<nil>", "Bangkok")
+
+	var got string
+	cache.apply(invalidation{Instance: cache.instance, Pattern: "admin_area*"})
+	if !cache.Get(ctx, "admin_area:code:1:THA.10_1:<nil>", &got) {
+		t.Errorf("own invalidation was applied")
+	}
+	cache.apply(invalidation{Instance: "other", Key: "admin_area:code:1:THA.10_1:<nil>"})
+	if cache.Get(ctx, "admin_area:code:1:THA.10_1:<nil>", &got) {
+		t.Errorf("invalidation of another instance was not applied")
+	}
+
+	cache.apply(invalidation{Instance: "other", Version: 3})
+	cache.apply(invalidation{Instance: "other", Version: 2})
+	if version := cache.Version(ctx); version != 3 {
+		t.Errorf("Version() = %d after renewals to 3 then 2, want 3", version)
+	}
+}
+
+func TestCacheLookupReportsStaleEntries(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCache(nil, Config{CacheTTL: time.Millisecond, CacheStaleTTL: time.Hour, CacheMemoryMB: 1})
+	cache.Set(ctx, "admin_area:list:1:full", "areas")
+	time.Sleep(5 * time.Millisecond)
+
+	var got string
+	if found, stale := cache.Lookup(ctx, "admin_area:list:1:full", &got); !found || !stale || got != "areas" {
+		t.Errorf("Lookup() = %v, %v, want the stale entry", found, stale)
+	}
+	if cache.Get(ctx, "admin_area:list:1:full", &got) {
+		t.Errorf("Get() returned a stale entry")
+	}
+	if cache.expiry("admin_area:list:1:full") != time.Hour+time.Millisecond {
+		t.Errorf("expiry() = %s, want the TTL and the stale window", cache.expiry("admin_area:list:1:full"))
+	}
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// datasetTablesFile creates the precomputed tables, which each dataset schema needs too
+const datasetTablesFile = "sql/0002_precomputed_tables.sql"
+
+// MigrateDataset creates the schema of a dataset and the precomputed tables in it, with
+// the same definitions as the unqualified ones. import gadm prepares the schemas it
+// loads; the migrate command catches up the configured ones.
+func MigrateDataset(ctx context.Context, db *gorm.DB, dataset string) error {
+	if dataset == "" {
+		return nil
+	}
+	sql, err := files.ReadFile(datasetTablesFile)
+	if err != nil {
+		return err
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		statements := []string{
+			"CREATE SCHEMA IF NOT EXISTS " + dataset,
+			// PostGIS types stay reachable through public
+			"SET LOCAL search_path TO " + dataset + ", public",
+			string(sql),
+		}
+		for _, statement := range statements {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
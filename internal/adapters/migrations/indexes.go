@@ -0,0 +1,143 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// indexLockKey serializes the index builds of concurrent migrate commands without
+// holding up instances applying the migrations at startup
+const indexLockKey = advisoryLockKey + 1
+
+// sourceIndex is an index on a table loaded by another tool, too large to build inside
+// a migration. An existing index with the method and definition fragment of Index,
+// as checked by Verify, is equivalent.
+type sourceIndex struct {
+	Table      string
+	Name       string
+	Expression string
+	Index      requiredIndex
+}
+
+// sourceIndexes lists the indexes BuildSourceIndexes builds for a dataset schema, ""
+// for the unqualified tables. The admin indexes are named as import gadm names them.
+func sourceIndexes(dataset string) []sourceIndex {
+	var indexes []sourceIndex
+	for level := 0; level <= 4; level++ {
+		name := "admin" + strconv.Itoa(level)
+		gid := "gid_" + strconv.Itoa(level)
+		nameColumn := "name_" + strconv.Itoa(level)
+		if level == 0 {
+			nameColumn = "country"
+		}
+		table := qualify(dataset, name)
+		indexes = append(indexes,
+			sourceIndex{Table: table, Name: name + "_geom_idx", Expression: "geom",
+				Index: requiredIndex{Method: "gist", Definition: "(geom)", Purpose: "spatial"}},
+			sourceIndex{Table: table, Name: name + "_gid_idx", Expression: gid,
+				Index: requiredIndex{Method: "btree", Definition: "(" + gid + ")", Purpose: "code"}},
+			sourceIndex{Table: table, Name: name + "_name_trgm_idx", Expression: nameColumn + " gin_trgm_ops",
+				Index: requiredIndex{Method: "gin", Definition: "gin_trgm_ops", Purpose: "trigram"}},
+		)
+	}
+	if dataset != "" {
+		return indexes
+	}
+
+	return append(indexes, sourceIndex{
+		Table: "planet_osm_line",
+		Name:  "planet_osm_line_name_trgm_idx",
+		// Matches the expression the road searches filter on
+		Expression: "(COALESCE(name, '') || ' ' || COALESCE(tags -> 'name:en', '')) gin_trgm_ops",
+		Index:      requiredIndex{Method: "gin", Definition: "gin_trgm_ops", Purpose: "trigram"},
+	})
+}
+
+// builtIndexes returns the indexes BuildSourceIndexes builds on a table
+func builtIndexes(dataset, table string) []requiredIndex {
+	var indexes []requiredIndex
+	for _, index := range sourceIndexes(dataset) {
+		if index.Table == table {
+			indexes = append(indexes, index.Index)
+		}
+	}
+	return indexes
+}
+
+// qualify prefixes a table or index name with the dataset schema
+func qualify(dataset, name string) string {
+	if dataset == "" {
+		return name
+	}
+	return dataset + "." + name
+}
+
+// BuildSourceIndexes builds the indexes of sourceIndexes on the tables already loaded,
+// unqualified and in the dataset schemas, that lack an equivalent one, and returns their
+// names. Each is built with CREATE INDEX CONCURRENTLY outside a transaction, so the
+// table stays writable and no lock is held against the instances starting meanwhile.
+// An interrupted build is retried by the next run. Only the migrate command runs it.
+func BuildSourceIndexes(ctx context.Context, db *gorm.DB, datasets []string) ([]string, error) {
+	var built []string
+	err := db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", indexLockKey).Error; err != nil {
+			return err
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", indexLockKey).Error; err != nil {
+				log.Printf("Failed to release the index lock: %v", err)
+			}
+		}()
+
+		for _, dataset := range append([]string{""}, datasets...) {
+			for _, index := range sourceIndexes(dataset) {
+				name, err := buildSourceIndex(conn, dataset, index)
+				if err != nil {
+					return fmt.Errorf("index %s: %w", qualify(dataset, index.Name), err)
+				}
+				if name != "" {
+					built = append(built, name)
+				}
+			}
+		}
+		return nil
+	})
+	return built, err
+}
+
+// buildSourceIndex builds an index unless its table is not loaded or has an equivalent
+// index, and returns its qualified name when built
+func buildSourceIndex(conn *gorm.DB, dataset string, index sourceIndex) (string, error) {
+	var state struct {
+		Loaded  bool
+		Indexed bool
+	}
+	err := conn.Raw(`
+		SELECT to_regclass(?) IS NOT NULL AS loaded, EXISTS (
+			SELECT 1 FROM pg_index i
+			JOIN pg_class c ON c.oid = i.indexrelid
+			JOIN pg_am am ON am.oid = c.relam
+			WHERE i.indrelid = to_regclass(?) AND i.indisvalid AND am.amname = ? AND strpos(pg_get_indexdef(i.indexrelid), ?) > 0
+		) AS indexed`, index.Table, index.Table, index.Index.Method, index.Index.Definition).Scan(&state).Error
+	if err != nil || !state.Loaded || state.Indexed {
+		return "", err
+	}
+
+	name := qualify(dataset, index.Name)
+	log.Printf("Building index %s, which can take a while...", name)
+	statements := []string{
+		// An interrupted concurrent build leaves an invalid index behind
+		"DROP INDEX CONCURRENTLY IF EXISTS " + name,
+		fmt.Sprintf("CREATE INDEX CONCURRENTLY IF NOT EXISTS %s ON %s USING %s (%s)", index.Name, index.Table, index.Index.Method, index.Expression),
+	}
+	for _, statement := range statements {
+		if err := conn.Exec(statement).Error; err != nil {
+			return "", err
+		}
+	}
+	return name, nil
+}
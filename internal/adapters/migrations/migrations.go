@@ -0,0 +1,121 @@
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// migrationsTable records the applied migrations
+const migrationsTable = "schema_migrations"
+
+// advisoryLockKey serializes instances migrating the same database at startup
+const advisoryLockKey = 7_246_501
+
+// Migration is one versioned SQL file, named <version>_<name>.sql
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// Load returns the embedded migrations ordered by version
+func Load() ([]Migration, error) {
+	entries, err := fs.ReadDir(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(entries))
+	seen := make(map[int]string, len(entries))
+	for _, entry := range entries {
+		version, name, err := parseFileName(entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("migrations %s and %s share version %d", other, entry.Name(), version)
+		}
+		seen[version] = entry.Name()
+
+		sql, err := files.ReadFile("sql/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, Migration{Version: version, Name: name, SQL: string(sql)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// parseFileName splits a migration file name such as 0003_geofences.sql into its version and name
+func parseFileName(fileName string) (int, string, error) {
+	base, ok := strings.CutSuffix(fileName, ".sql")
+	if !ok {
+		return 0, "", fmt.Errorf("migration %s is not a .sql file", fileName)
+	}
+	prefix, name, ok := strings.Cut(base, "_")
+	version, err := strconv.Atoi(prefix)
+	if !ok || err != nil || version <= 0 || name == "" {
+		return 0, "", fmt.Errorf("migration %s is not named <version>_<name>.sql", fileName)
+	}
+	return version, name, nil
+}
+
+// Migrate applies the migrations not applied yet, each in its own transaction, and
+// returns them. Instances starting together wait on an advisory lock, and a migration
+// applied by another instance meanwhile is skipped.
+func Migrate(ctx context.Context, db *gorm.DB) ([]Migration, error) {
+	migrations, err := Load()
+	if err != nil {
+		return nil, err
+	}
+
+	createTable := `CREATE TABLE IF NOT EXISTS ` + migrationsTable + ` (
+    version integer PRIMARY KEY,
+    name text NOT NULL,
+    applied_at timestamptz NOT NULL DEFAULT now()
+)`
+	if err := db.WithContext(ctx).Exec(createTable).Error; err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range migrations {
+		ran := false
+		err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", advisoryLockKey).Error; err != nil {
+				return err
+			}
+			var done bool
+			if err := tx.Raw("SELECT EXISTS (SELECT 1 FROM "+migrationsTable+" WHERE version = ?)", migration.Version).Scan(&done).Error; err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+			if err := tx.Exec(migration.SQL).Error; err != nil {
+				return err
+			}
+			ran = true
+			return tx.Exec("INSERT INTO "+migrationsTable+" (version, name) VALUES (?, ?)", migration.Version, migration.Name).Error
+		})
+		if err != nil {
+			return applied, fmt.Errorf("migration %04d_%s: %w", migration.Version, migration.Name, err)
+		}
+		if ran {
+			applied = append(applied, migration)
+		}
+	}
+	return applied, nil
+}
@@ -0,0 +1,89 @@
+package migrations
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(migrations) == 0 {
+		t.Fatal("Load() found no migrations")
+	}
+	for i, migration := range migrations {
+		// Versions are contiguous so a missing file is noticed
+		if migration.Version != i+1 {
+			t.Errorf("migration %d has version %d, want %d", i, migration.Version, i+1)
+		}
+		if strings.TrimSpace(migration.SQL) == "" {
+			t.Errorf("migration %04d_%s is empty", migration.Version, migration.Name)
+		}
+	}
+}
+
+func TestParseFileName(t *testing.T) {
+	version, name, err := parseFileName("0003_geofences.sql")
+	if err != nil || version != 3 || name != "geofences" {
+		t.Errorf("parseFileName() = %d, %q, %v, want 3, geofences", version, name, err)
+	}
+
+	for _, fileName := range []string{"geofences.sql", "0003_geofences.txt", "0000_init.sql", "v1_init.sql", "0004_.sql"} {
+		if _, _, err := parseFileName(fileName); err == nil {
+			t.Errorf("parseFileName(%q) accepted an invalid name", fileName)
+		}
+	}
+}
+
+func TestRequiredTables(t *testing.T) {
+	names := func(tables []requiredTable) map[string]requiredTable {
+		byName := make(map[string]requiredTable, len(tables))
+		for _, table := range tables {
+			byName[table.Name] = table
+		}
+		return byName
+	}
+
+	unqualified := names(requiredTables(""))
+	for _, name := range []string{"admin0", "admin4", "admin_simplified", "planet_osm_line", "geofences"} {
+		if _, ok := unqualified[name]; !ok {
+			t.Errorf("requiredTables(\"\") lacks %s", name)
+		}
+	}
+	if columns := unqualified["admin2"].Columns; !strings.Contains(strings.Join(columns, ","), "gid_2,name_1,name_2") {
+		t.Errorf("admin2 columns = %v", columns)
+	}
+
+	// Dataset schemas only hold the GADM tables and their precomputations
+	dataset := names(requiredTables("gadm41"))
+	if _, ok := dataset["gadm41.admin1"]; !ok {
+		t.Error("requiredTables(gadm41) lacks gadm41.admin1")
+	}
+	if _, ok := dataset["planet_osm_line"]; ok {
+		t.Error("requiredTables(gadm41) includes planet_osm_line")
+	}
+	if fix := dataset["gadm41.admin1"].Source; fix != "run import gadm --dataset gadm41" {
+		t.Errorf("gadm41.admin1 fix = %q", fix)
+	}
+	// The indexes of every dataset are checked as BuildSourceIndexes builds them
+	if indexes := dataset["gadm41.admin1"].Indexes; len(indexes) != 3 || indexes[1].Definition != "(gid_1)" {
+		t.Errorf("gadm41.admin1 indexes = %v", indexes)
+	}
+}
+
+func TestMigrationsLeaveSourceIndexesOut(t *testing.T) {
+	migrations, err := Load()
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	// Migrations run in a transaction at startup; the large indexes are built concurrently by the migrate command
+	for _, migration := range migrations {
+		for _, index := range sourceIndexes("") {
+			if strings.Contains(migration.SQL, index.Table) {
+				t.Errorf("migration %04d_%s touches %s, which BuildSourceIndexes indexes", migration.Version, migration.Name, index.Table)
+			}
+		}
+	}
+}
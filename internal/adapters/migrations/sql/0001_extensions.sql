@@ -0,0 +1,4 @@
+-- PostGIS for every geometry, pg_trgm for fuzzy road search and hstore for the OSM tags
+CREATE EXTENSION IF NOT EXISTS postgis;
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+CREATE EXTENSION IF NOT EXISTS hstore;
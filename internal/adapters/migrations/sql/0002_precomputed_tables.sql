@@ -0,0 +1,18 @@
+-- Precomputed simplified geometries for every admin level, keyed by simplification mode and tolerance
+CREATE TABLE IF NOT EXISTS admin_simplified (
+    admin_level smallint NOT NULL,
+    ogc_fid integer NOT NULL,
+    mode text NOT NULL,
+    tolerance double precision NOT NULL,
+    geom geometry(MultiPolygon, 4326),
+    PRIMARY KEY (admin_level, mode, tolerance, ogc_fid)
+);
+
+-- Precomputed neighbours of every admin area, in both directions
+CREATE TABLE IF NOT EXISTS admin_adjacency (
+    admin_level smallint NOT NULL,
+    ogc_fid integer NOT NULL,
+    neighbor_fid integer NOT NULL,
+    shared_border_km double precision NOT NULL,
+    PRIMARY KEY (admin_level, ogc_fid, neighbor_fid)
+);
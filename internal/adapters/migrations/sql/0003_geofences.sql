@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS geofences (
+    id serial PRIMARY KEY,
+    name text NOT NULL,
+    description text,
+    boundary_codes jsonb NOT NULL DEFAULT '[]',
+    geom geometry(MultiPolygon, 4326) NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS geofences_geom_idx ON geofences USING gist (geom);
@@ -0,0 +1,183 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// Problem is a missing requirement of the schema and how to fix it
+type Problem struct {
+	Missing string
+	Fix     string
+}
+
+func (p Problem) String() string {
+	return p.Missing + ": " + p.Fix
+}
+
+// requiredIndex is an index a table must have, matched on its access method and,
+// when set, a fragment of its definition
+type requiredIndex struct {
+	Method     string
+	Definition string
+	Purpose    string
+}
+
+// requiredTable is a table the queries read, what loads it and what creates its indexes
+type requiredTable struct {
+	Name     string
+	Columns  []string
+	Indexes  []requiredIndex
+	Source   string
+	IndexFix string
+}
+
+// requiredExtensions are the extensions the queries call into
+var requiredExtensions = []string{"postgis", "pg_trgm", "hstore"}
+
+const (
+	migrateFix    = "run the migrate command"
+	buildIndexFix = "run the migrate command; AUTO_MIGRATE does not build this index"
+	importGADMFix = "run import gadm"
+	osm2pgsqlFix  = "load OpenStreetMap with osm2pgsql --hstore"
+)
+
+// requiredTables lists the tables of a dataset schema, "" for the unqualified tables.
+// The precomputed tables of the dataset schemas are created by import gadm and by
+// the migrate command, see MigrateDataset.
+func requiredTables(dataset string) []requiredTable {
+	source := importGADMFix
+	if dataset != "" {
+		source = importGADMFix + " --dataset " + dataset
+	}
+
+	var tables []requiredTable
+	for level := 0; level <= 4; level++ {
+		name := "admin" + strconv.Itoa(level)
+		columns := []string{"ogc_fid", "country", "geom"}
+		for n := 0; n <= level; n++ {
+			columns = append(columns, "gid_"+strconv.Itoa(n))
+		}
+		for n := 1; n <= level; n++ {
+			columns = append(columns, "name_"+strconv.Itoa(n))
+		}
+		tables = append(tables, requiredTable{
+			Name:     qualify(dataset, name),
+			Columns:  columns,
+			Indexes:  builtIndexes(dataset, qualify(dataset, name)),
+			Source:   source,
+			IndexFix: buildIndexFix,
+		})
+	}
+	tables = append(tables,
+		requiredTable{Name: qualify(dataset, "admin_simplified"), Columns: []string{"admin_level", "ogc_fid", "mode", "tolerance", "geom"}, Source: migrateFix},
+		requiredTable{Name: qualify(dataset, "admin_adjacency"), Columns: []string{"admin_level", "ogc_fid", "neighbor_fid", "shared_border_km"}, Source: migrateFix},
+	)
+	if dataset != "" {
+		return tables
+	}
+
+	return append(tables,
+		requiredTable{
+			Name:    "planet_osm_line",
+			Columns: []string{"name", "tags", "way"},
+			// osm2pgsql creates the spatial index
+			Indexes:  append([]requiredIndex{{Method: "gist", Definition: "(way)", Purpose: "spatial"}}, builtIndexes("", "planet_osm_line")...),
+			Source:   osm2pgsqlFix,
+			IndexFix: buildIndexFix,
+		},
+		requiredTable{
+			Name:     "geofences",
+			Columns:  []string{"id", "name", "description", "boundary_codes", "geom", "created_at", "updated_at"},
+			Indexes:  []requiredIndex{{Method: "gist", Definition: "(geom)", Purpose: "spatial"}},
+			Source:   migrateFix,
+			IndexFix: migrateFix,
+		},
+	)
+}
+
+// Verify checks the extensions, tables, columns and indexes the queries rely on, for
+// the unqualified tables and each dataset schema, and returns what is missing.
+// Nothing is changed; a missing index only makes queries slow, but the other
+// problems make them fail.
+func Verify(ctx context.Context, db *gorm.DB, datasets []string) ([]Problem, error) {
+	var problems []Problem
+
+	var installed []string
+	if err := db.WithContext(ctx).Raw("SELECT extname FROM pg_extension").Scan(&installed).Error; err != nil {
+		return nil, err
+	}
+	for _, extension := range requiredExtensions {
+		if !slices.Contains(installed, extension) {
+			problems = append(problems, Problem{
+				Missing: "extension " + extension,
+				Fix:     migrateFix + ", or CREATE EXTENSION " + extension + " as a superuser",
+			})
+		}
+	}
+
+	for _, dataset := range append([]string{""}, datasets...) {
+		for _, table := range requiredTables(dataset) {
+			tableProblems, err := verifyTable(ctx, db, table)
+			if err != nil {
+				return nil, err
+			}
+			problems = append(problems, tableProblems...)
+		}
+	}
+	return problems, nil
+}
+
+func verifyTable(ctx context.Context, db *gorm.DB, table requiredTable) ([]Problem, error) {
+	var exists bool
+	if err := db.WithContext(ctx).Raw("SELECT to_regclass(?) IS NOT NULL", table.Name).Scan(&exists).Error; err != nil {
+		return nil, err
+	}
+	if !exists {
+		return []Problem{{Missing: "table " + table.Name, Fix: table.Source}}, nil
+	}
+
+	var columns []string
+	err := db.WithContext(ctx).Raw(
+		"SELECT attname FROM pg_attribute WHERE attrelid = to_regclass(?) AND attnum > 0 AND NOT attisdropped",
+		table.Name,
+	).Scan(&columns).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []Problem
+	for _, column := range table.Columns {
+		if !slices.Contains(columns, column) {
+			problems = append(problems, Problem{
+				Missing: fmt.Sprintf("column %s.%s", table.Name, column),
+				Fix:     "reload the table: " + table.Source,
+			})
+		}
+	}
+
+	for _, index := range table.Indexes {
+		var found bool
+		err := db.WithContext(ctx).Raw(`
+			SELECT EXISTS (
+				SELECT 1 FROM pg_index i
+				JOIN pg_class c ON c.oid = i.indexrelid
+				JOIN pg_am am ON am.oid = c.relam
+				WHERE i.indrelid = to_regclass(?) AND i.indisvalid AND am.amname = ? AND strpos(pg_get_indexdef(i.indexrelid), ?) > 0
+			)`, table.Name, index.Method, index.Definition).Scan(&found).Error
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			problems = append(problems, Problem{
+				Missing: fmt.Sprintf("%s index on %s %s", index.Purpose, table.Name, index.Definition),
+				Fix:     table.IndexFix,
+			})
+		}
+	}
+	return problems, nil
+}
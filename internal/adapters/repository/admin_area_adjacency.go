@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/repository/models"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"gorm.io/gorm"
+)
+
+// adjacencyTable stores the precomputed neighbours of every admin area, in both
+// directions, with the length of the border they share.
+const adjacencyTable = "admin_adjacency"
+
+// insertAdjacency finds each touching pair once and stores it in both directions.
+// Areas meeting at a single point are neighbours with a shared border of 0 km.
+const insertAdjacency = `
+WITH pairs AS (
+    SELECT
+        a.ogc_fid AS a_fid,
+        b.ogc_fid AS b_fid,
+        ST_Length(ST_CollectionExtract(ST_Intersection(a.geom, b.geom), 2)::geography) / 1e3 AS km
+    FROM %[1]s a
+    JOIN %[1]s b ON a.ogc_fid < b.ogc_fid AND ST_Touches(a.geom, b.geom)
+)
+INSERT INTO %[2]s (admin_level, ogc_fid, neighbor_fid, shared_border_km)
+SELECT ?, a_fid, b_fid, km FROM pairs
+UNION ALL
+SELECT ?, b_fid, a_fid, km FROM pairs`
+
+// PrecomputeAdjacency implements [ports.AdminAreaRepository].
+// The rows for the level are replaced in a single transaction.
+func (c *adminAreaRepository) PrecomputeAdjacency(ctx context.Context, adminLevel int32) error {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	query, ok := d.level(adminLevel)
+	if !ok {
+		return errors.New("invalid admin level")
+	}
+
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM "+d.table(adjacencyTable)+" WHERE admin_level = ?", adminLevel).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf(insertAdjacency, query.Table, d.table(adjacencyTable)), adminLevel, adminLevel).Error
+	})
+}
+
+// GetNeighbors implements [ports.AdminAreaRepository].
+// Neighbours are read from the adjacency table, longest shared border first. An area
+// without rows is an island only if the level has been precomputed at all.
+func (c *adminAreaRepository) GetNeighbors(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminAreaNeighbor, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := d.level(adminLevel); !ok {
+		return nil, errors.New("invalid admin level")
+	}
+
+	var edges []struct {
+		NeighborFid    int
+		SharedBorderKm float64
+	}
+	err = c.db.WithContext(ctx).Table(d.table(adjacencyTable)).
+		Select("neighbor_fid, shared_border_km").
+		Where("admin_level = ? AND ogc_fid = ?", adminLevel, id).
+		Order("shared_border_km DESC, neighbor_fid").
+		Scan(&edges).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(edges) == 0 {
+		var precomputed bool
+		if err := c.db.WithContext(ctx).Raw("SELECT EXISTS (SELECT 1 FROM "+d.table(adjacencyTable)+" WHERE admin_level = ?)", adminLevel).Scan(&precomputed).Error; err != nil {
+			return nil, err
+		}
+		if !precomputed {
+			return nil, fmt.Errorf("adjacency for admin level %d has not been precomputed", adminLevel)
+		}
+		return []*domain.AdminAreaNeighbor{}, nil
+	}
+
+	ids := make([]int, len(edges))
+	for i, edge := range edges {
+		ids[i] = edge.NeighborFid
+	}
+	areas, err := c.listByIDs(ctx, d, ids, adminLevel, opts)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*domain.AdminArea, len(areas))
+	for _, area := range areas {
+		byID[area.ID] = area
+	}
+
+	neighbors := make([]*domain.AdminAreaNeighbor, 0, len(edges))
+	for _, edge := range edges {
+		// Skip areas removed from the level since the last precomputation
+		if area, ok := byID[edge.NeighborFid]; ok {
+			neighbors = append(neighbors, &domain.AdminAreaNeighbor{Area: area, SharedBorderKm: edge.SharedBorderKm})
+		}
+	}
+	return neighbors, nil
+}
+
+func (c *adminAreaRepository) listByIDs(ctx context.Context, d dataset, ids []int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	switch adminLevel {
+	case 0:
+		return listByIDs[models.AdminArea0](c.db, d, ctx, ids, adminLevel, opts)
+	case 1:
+		return listByIDs[models.AdminArea1](c.db, d, ctx, ids, adminLevel, opts)
+	case 2:
+		return listByIDs[models.AdminArea2](c.db, d, ctx, ids, adminLevel, opts)
+	case 3:
+		return listByIDs[models.AdminArea3](c.db, d, ctx, ids, adminLevel, opts)
+	case 4:
+		return listByIDs[models.AdminArea4](c.db, d, ctx, ids, adminLevel, opts)
+	default:
+		return nil, errors.New("invalid admin level")
+	}
+}
+
+func listByIDs[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, ids []int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	query, _ := d.level(adminLevel)
+	var adminAreas []T
+	selectClause := getSelectClause(d, adminLevel, opts)
+	q := db.WithContext(ctx).Table(query.Table).Select(selectClause)
+	if err := q.Where("ogc_fid IN ?", ids).Scan(&adminAreas).Error; err != nil {
+		return nil, err
+	}
+	result := models.MapAdminSliceToDomain(adminAreas)
+	d.stamp(result...)
+	if err := checkSimplifiedLoaded(result, adminLevel, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+)
+
+// crosswalkMinShare drops overlaps covering less than this share of both areas,
+// which are slivers from borders redrawn between releases rather than real matches
+const crosswalkMinShare = 0.001
+
+// crosswalkSQL intersects an area of the source dataset with the areas of the same
+// level in the target dataset, measuring on geography so shares hold at any latitude
+const crosswalkSQL = `
+WITH
+	source AS (
+		SELECT geom, ST_Area(geom::geography) AS area FROM %[1]s WHERE gid_%[3]d = ?
+	),
+	overlaps AS (
+		SELECT
+			t.ogc_fid,
+			ST_Area(ST_Intersection(t.geom, s.geom)::geography) AS overlap,
+			ST_Area(t.geom::geography) AS area,
+			s.area AS source_area
+		FROM %[2]s t, source s
+		WHERE ST_Intersects(t.geom, s.geom)
+	),
+	shares AS (
+		SELECT
+			ogc_fid,
+			COALESCE(overlap / NULLIF(source_area, 0), 0) AS source_share,
+			COALESCE(overlap / NULLIF(area, 0), 0) AS target_share
+		FROM overlaps
+	)
+SELECT * FROM shares
+WHERE source_share >= ? OR target_share >= ?
+ORDER BY source_share DESC, ogc_fid`
+
+// Crosswalk implements [ports.AdminAreaRepository].
+// The code is resolved to its version in the source dataset, so a code whose version
+// changed between releases still finds its counterpart.
+func (c *adminAreaRepository) Crosswalk(ctx context.Context, code string, adminLevel int32, from, to string, opts domain.GeometryOptions) ([]*domain.CrosswalkMatch, error) {
+	source, err := c.datasets.named(from)
+	if err != nil {
+		return nil, err
+	}
+	target, err := c.datasets.named(to)
+	if err != nil {
+		return nil, err
+	}
+	sourceQuery, ok := source.level(adminLevel)
+	if !ok {
+		return nil, errors.New("invalid admin level")
+	}
+	targetQuery, _ := target.level(adminLevel)
+
+	gid, err := resolveGID(ctx, c.db, source, code, adminLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		OgcFid      int
+		SourceShare float64
+		TargetShare float64
+	}
+	sql := fmt.Sprintf(crosswalkSQL, sourceQuery.Table, targetQuery.Table, adminLevel)
+	if err := c.db.WithContext(ctx).Raw(sql, gid, crosswalkMinShare, crosswalkMinShare).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		var count int64
+		if err := c.db.WithContext(ctx).Table(sourceQuery.Table).Where("gid_"+strconv.Itoa(int(adminLevel))+" = ?", gid).Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count == 0 {
+			return nil, fmt.Errorf("%w: %s", domain.ErrBoundaryNotFound, code)
+		}
+		return []*domain.CrosswalkMatch{}, nil
+	}
+
+	ids := make([]int, len(rows))
+	for i, row := range rows {
+		ids[i] = row.OgcFid
+	}
+	areas, err := c.listByIDs(ctx, target, ids, adminLevel, opts)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[int]*domain.AdminArea, len(areas))
+	for _, area := range areas {
+		byID[area.ID] = area
+	}
+
+	matches := make([]*domain.CrosswalkMatch, 0, len(rows))
+	for _, row := range rows {
+		if area, ok := byID[row.OgcFid]; ok {
+			matches = append(matches, &domain.CrosswalkMatch{Area: area, SourceShare: row.SourceShare, TargetShare: row.TargetShare})
+		}
+	}
+	return matches, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,184 +15,236 @@ import (
 )
 
 type adminAreaRepository struct {
-	db *gorm.DB
+	db       *gorm.DB
+	datasets Datasets
 }
 
-func NewAdminAreaRepository(db *gorm.DB) ports.AdminAreaRepository {
-	return &adminAreaRepository{db: db}
+// NewAdminAreaRepository reads the admin tables of the datasets. The precomputed tables
+// are created by the migrations and, in dataset schemas, by import gadm.
+func NewAdminAreaRepository(db *gorm.DB, datasets Datasets) ports.AdminAreaRepository {
+	return &adminAreaRepository{db: db, datasets: datasets}
 }
 
-var queries = map[int32]struct{ Table, Select, OrderBy string }{
-	0: {"admin0", "ogc_fid, gid_0, country, ST_AsGeoJSON(geom) AS geom", "country"},
-	1: {"admin1", "ogc_fid, gid_0, gid_1, name_1, ST_AsGeoJSON(geom) AS geom", "name_1"},
-	2: {"admin2", "ogc_fid, gid_0, gid_1, gid_2, name_2, ST_AsGeoJSON(geom) AS geom", "name_2"},
-	3: {"admin3", "ogc_fid, gid_0, gid_1, gid_2, gid_3, name_3, ST_AsGeoJSON(geom) AS geom", "name_3"},
-	4: {"admin4", "ogc_fid, gid_0, gid_1, gid_2, gid_3, gid_4, name_4, ST_AsGeoJSON(geom) AS geom", "name_4"},
+// levelQuery describes the table of an admin level
+type levelQuery struct{ Table, Select, OrderBy string }
+
+// Select lists the attribute columns only; getSelectClause appends the geometry
+// column when the caller asks for it. Tables are unqualified, see [dataset.level].
+var queries = map[int32]levelQuery{
+	0: {"admin0", "ogc_fid, gid_0, country", "country"},
+	1: {"admin1", "ogc_fid, gid_0, gid_1, name_1", "name_1"},
+	2: {"admin2", "ogc_fid, gid_0, gid_1, gid_2, name_2", "name_2"},
+	3: {"admin3", "ogc_fid, gid_0, gid_1, gid_2, gid_3, name_3", "name_3"},
+	4: {"admin4", "ogc_fid, gid_0, gid_1, gid_2, gid_3, gid_4, name_4", "name_4"},
 }
 
 // GetByID implements ports.AdminAreaRepository.
-func (c *adminAreaRepository) GetByID(ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
+func (c *adminAreaRepository) GetByID(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
 	switch adminLevel {
 	case 0:
-		return getByID[models.AdminArea0](c.db, ctx, id, adminLevel, tolerance)
+		return getByID[models.AdminArea0](c.db, d, ctx, id, adminLevel, opts)
 	case 1:
-		return getByID[models.AdminArea1](c.db, ctx, id, adminLevel, tolerance)
+		return getByID[models.AdminArea1](c.db, d, ctx, id, adminLevel, opts)
 	case 2:
-		return getByID[models.AdminArea2](c.db, ctx, id, adminLevel, tolerance)
+		return getByID[models.AdminArea2](c.db, d, ctx, id, adminLevel, opts)
 	case 3:
-		return getByID[models.AdminArea3](c.db, ctx, id, adminLevel, tolerance)
+		return getByID[models.AdminArea3](c.db, d, ctx, id, adminLevel, opts)
 	case 4:
-		return getByID[models.AdminArea4](c.db, ctx, id, adminLevel, tolerance)
+		return getByID[models.AdminArea4](c.db, d, ctx, id, adminLevel, opts)
 	default:
 		return nil, errors.New("invalid admin level")
 	}
 }
 
 // List implements ports.AdminAreaRepository.
-func (c *adminAreaRepository) List(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
+func (c *adminAreaRepository) List(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
 	switch adminLevel {
 	case 0:
-		return list[models.AdminArea0](c.db, ctx, adminLevel, tolerance)
+		return list[models.AdminArea0](c.db, d, ctx, adminLevel, opts)
 	case 1:
-		return list[models.AdminArea1](c.db, ctx, adminLevel, tolerance)
+		return list[models.AdminArea1](c.db, d, ctx, adminLevel, opts)
 	case 2:
-		return list[models.AdminArea2](c.db, ctx, adminLevel, tolerance)
+		return list[models.AdminArea2](c.db, d, ctx, adminLevel, opts)
 	case 3:
-		return list[models.AdminArea3](c.db, ctx, adminLevel, tolerance)
+		return list[models.AdminArea3](c.db, d, ctx, adminLevel, opts)
 	case 4:
-		return list[models.AdminArea4](c.db, ctx, adminLevel, tolerance)
+		return list[models.AdminArea4](c.db, d, ctx, adminLevel, opts)
 	default:
 		return nil, errors.New("invalid admin level")
 	}
 }
 
 // GetByCode implements [ports.AdminAreaRepository].
-func (c *adminAreaRepository) GetByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
+func (c *adminAreaRepository) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
 	switch adminLevel {
 	case 0:
-		return getByCode[models.AdminArea0](c.db, ctx, code, adminLevel, tolerance)
+		return getByCode[models.AdminArea0](c.db, d, ctx, code, adminLevel, opts)
 	case 1:
-		return getByCode[models.AdminArea1](c.db, ctx, code, adminLevel, tolerance)
+		return getByCode[models.AdminArea1](c.db, d, ctx, code, adminLevel, opts)
 	case 2:
-		return getByCode[models.AdminArea2](c.db, ctx, code, adminLevel, tolerance)
+		return getByCode[models.AdminArea2](c.db, d, ctx, code, adminLevel, opts)
 	case 3:
-		return getByCode[models.AdminArea3](c.db, ctx, code, adminLevel, tolerance)
+		return getByCode[models.AdminArea3](c.db, d, ctx, code, adminLevel, opts)
 	case 4:
-		return getByCode[models.AdminArea4](c.db, ctx, code, adminLevel, tolerance)
+		return getByCode[models.AdminArea4](c.db, d, ctx, code, adminLevel, opts)
 	default:
 		return nil, errors.New("invalid admin level")
 	}
 }
 
 // GetChildren implements [ports.AdminAreaRepository].
-func (c *adminAreaRepository) GetChildren(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
+func (c *adminAreaRepository) GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
 	switch childLevel {
 	case 1:
-		return getChildren[models.AdminArea1](c.db, ctx, parentCode, childLevel, tolerance)
+		return getChildren[models.AdminArea1](c.db, d, ctx, parentCode, childLevel, opts)
 	case 2:
-		return getChildren[models.AdminArea2](c.db, ctx, parentCode, childLevel, tolerance)
+		return getChildren[models.AdminArea2](c.db, d, ctx, parentCode, childLevel, opts)
 	case 3:
-		return getChildren[models.AdminArea3](c.db, ctx, parentCode, childLevel, tolerance)
+		return getChildren[models.AdminArea3](c.db, d, ctx, parentCode, childLevel, opts)
 	case 4:
-		return getChildren[models.AdminArea4](c.db, ctx, parentCode, childLevel, tolerance)
+		return getChildren[models.AdminArea4](c.db, d, ctx, parentCode, childLevel, opts)
 	default:
 		return nil, errors.New("invalid child level")
 	}
 }
 
-func getByID[T models.AdminArea](db *gorm.DB, ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	query := queries[adminLevel]
+func getByID[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	query, _ := d.level(adminLevel)
 	var adminArea T
-	selectClause := getSelectClause(query.Select, tolerance)
+	selectClause := getSelectClause(d, adminLevel, opts)
 	q := db.WithContext(ctx).Table(query.Table).Select(selectClause)
 	if err := q.First(&adminArea, id).Error; err != nil {
 		return nil, err
 	}
-	return adminArea.ToDomain(), nil
+	result := adminArea.ToDomain()
+	d.stamp(result)
+	if err := checkSimplifiedLoaded([]*domain.AdminArea{result}, adminLevel, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func list[T models.AdminArea](db *gorm.DB, ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	query := queries[adminLevel]
+func list[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	query, _ := d.level(adminLevel)
 	var adminAreas []T
-	selectClause := getSelectClause(query.Select, tolerance)
+	selectClause := getSelectClause(d, adminLevel, opts)
 	q := db.WithContext(ctx).Table(query.Table).Select(selectClause)
 	if err := q.Order(query.OrderBy).Scan(&adminAreas).Error; err != nil {
 		return nil, err
 	}
-	return models.MapAdminSliceToDomain(adminAreas), nil
+	result := models.MapAdminSliceToDomain(adminAreas)
+	d.stamp(result...)
+	if err := checkSimplifiedLoaded(result, adminLevel, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func getByCode[T models.AdminArea](db *gorm.DB, ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	query := queries[adminLevel]
-	gidCol := "gid_" + strconv.Itoa(int(adminLevel))
+func getByCode[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	query, _ := d.level(adminLevel)
+	gid, err := resolveGID(ctx, db, d, code, adminLevel)
+	if err != nil {
+		return nil, err
+	}
 	var adminArea T
-	selectClause := getSelectClause(query.Select, tolerance)
+	selectClause := getSelectClause(d, adminLevel, opts)
 
-	whereClause, args := buildGIDWhereClause(gidCol, code, adminLevel)
-	q := db.WithContext(ctx).Table(query.Table).Select(selectClause).Where(whereClause, args...)
+	gidCol := "gid_" + strconv.Itoa(int(adminLevel))
+	q := db.WithContext(ctx).Table(query.Table).Select(selectClause).Where(gidCol+" = ?", gid)
 
 	if err := q.First(&adminArea).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrBoundaryNotFound, code)
+		}
 		return nil, err
 	}
 
-	return adminArea.ToDomain(), nil
+	result := adminArea.ToDomain()
+	d.stamp(result)
+	if err := checkSimplifiedLoaded([]*domain.AdminArea{result}, adminLevel, opts); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
-func getChildren[T models.AdminArea](db *gorm.DB, ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	query := queries[childLevel]
+func getChildren[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	query, _ := d.level(childLevel)
+	parentGID, err := resolveGID(ctx, db, d, parentCode, childLevel-1)
+	if err != nil {
+		return nil, err
+	}
 	whereClause := "gid_" + strconv.Itoa(int(childLevel-1)) + " = ?"
 	var adminAreas []T
-	selectClause := getSelectClause(query.Select, tolerance)
+	selectClause := getSelectClause(d, childLevel, opts)
 	q := db.WithContext(ctx).Table(query.Table).Select(selectClause)
-	if err := q.Where(whereClause, parentCode).Order(query.OrderBy).Scan(&adminAreas).Error; err != nil {
+	if err := q.Where(whereClause, parentGID).Order(query.OrderBy).Scan(&adminAreas).Error; err != nil {
 		return nil, err
 	}
-	return models.MapAdminSliceToDomain(adminAreas), nil
-}
-
-func getSelectClause(baseSelect string, tolerance *float64) string {
-	if tolerance != nil && *tolerance > 0 {
-		// Replace geom with simplified geometry using tolerance value
-		return strings.ReplaceAll(
-			baseSelect,
-			"ST_AsGeoJSON(geom) AS geom",
-			fmt.Sprintf("ST_AsGeoJSON(ST_SimplifyPreserveTopology(geom, %f)) AS geom", *tolerance),
-		)
+	result := models.MapAdminSliceToDomain(adminAreas)
+	d.stamp(result...)
+	if err := checkSimplifiedLoaded(result, childLevel, opts); err != nil {
+		return nil, err
 	}
-	return baseSelect
+	return result, nil
 }
 
-func buildGIDWhereClause(gidColumn, code string, adminLevel int32) (whereClause string, args []any) {
-	// Level 0 has no versioning, use exact match
-	if adminLevel == 0 {
-		return gidColumn + " = ?", []any{code}
+func getSelectClause(d dataset, adminLevel int32, opts domain.GeometryOptions) string {
+	query, _ := d.level(adminLevel)
+	if opts.Omit {
+		// Geometry was not requested, skip the expensive ST_AsGeoJSON serialization
+		return query.Select
 	}
-
-	// For other levels, handle versioning
-	if strings.Contains(code, "_") {
-		return gidColumn + " = ?", []any{code} // if code already contain versioning, use exact match otherwise LIKE
+	if opts.Simplification == domain.SimplificationCoverage && opts.Tolerance != nil {
+		// Read the precomputed coverage so neighbouring areas share the same simplified borders
+		return query.Select + ", ST_AsGeoJSON(" + simplifiedGeomSubquery(d, query.Table, adminLevel, opts.Simplification, *opts.Tolerance) + ") AS geom"
 	}
-	return gidColumn + " LIKE ? ESCAPE '\\'", []any{code + "\\_%"}
+	if opts.Tolerance != nil && slices.Contains(domain.ZoomTolerances, *opts.Tolerance) {
+		// Zoom preset: prefer the precomputed geometry, simplify on the fly if it is missing
+		return query.Select + fmt.Sprintf(", ST_AsGeoJSON(COALESCE(%s, ST_SimplifyPreserveTopology(geom, %f))) AS geom",
+			simplifiedGeomSubquery(d, query.Table, adminLevel, domain.SimplificationStandard, *opts.Tolerance), *opts.Tolerance)
+	}
+	if opts.Tolerance != nil && *opts.Tolerance > 0 {
+		// Use simplified geometry using tolerance value
+		return query.Select + fmt.Sprintf(", ST_AsGeoJSON(ST_SimplifyPreserveTopology(geom, %f)) AS geom", *opts.Tolerance)
+	}
+	return query.Select + ", ST_AsGeoJSON(geom) AS geom"
 }
 
 // FilterCoordinatesByBoundary implements [ports.AdminAreaRepository].
 func (c *adminAreaRepository) FilterCoordinatesByBoundary(ctx context.Context, coordinates [][2]float64, boundaryID string, adminLevel int32) ([]*domain.FilteredCoordinate, error) {
-	query := queries[adminLevel]
-	if query.Table == "" {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := d.level(adminLevel)
+	if !ok {
 		return nil, errors.New("invalid admin level")
 	}
 
-	// Build VALUES clause for coordinates
-	// Format: (idx, lat, lon), (idx, lat, lon), ...
-	valuesClauses := make([]string, len(coordinates))
-	for i, coord := range coordinates {
-		valuesClauses[i] = fmt.Sprintf("(%d, %f, %f)", i, coord[0], coord[1])
-	}
-	valuesSQL := strings.Join(valuesClauses, ", ")
+	valuesSQL := coordinateValues(coordinates)
 
-	// Build GID WHERE clause that handles versioning
-	gidCol := "gid_" + strconv.Itoa(int(adminLevel))
-	whereClause, args := buildGIDWhereClause(gidCol, boundaryID, adminLevel)
+	// Match the GID exactly once the code is resolved to its version
+	gid, err := resolveGID(ctx, c.db, d, boundaryID, adminLevel)
+	if err != nil {
+		return nil, err
+	}
+	whereClause, args := "gid_"+strconv.Itoa(int(adminLevel))+" = ?", []any{gid}
 
 	// Build SQL query using CTE
 	// Uses ST_Contains to filter coordinates within the boundary polygon
@@ -213,13 +266,7 @@ func (c *adminAreaRepository) FilterCoordinatesByBoundary(ctx context.Context, c
 		ORDER BY c.idx
 	`, query.Table, whereClause, valuesSQL)
 
-	type Result struct {
-		Idx int
-		Lat float64
-		Lon float64
-	}
-
-	var results []Result
+	var results []*domain.FilteredCoordinate
 	if err := c.db.WithContext(ctx).Raw(sql, args...).Scan(&results).Error; err != nil {
 		return nil, err
 	}
@@ -231,19 +278,266 @@ func (c *adminAreaRepository) FilterCoordinatesByBoundary(ctx context.Context, c
 			return nil, err
 		}
 		if count == 0 {
-			return nil, fmt.Errorf("boundary not found: %s", boundaryID)
+			return nil, fmt.Errorf("%w: %s", domain.ErrBoundaryNotFound, boundaryID)
 		}
 	}
 
-	// Convert results to domain format
-	output := make([]*domain.FilteredCoordinate, len(results))
-	for i, r := range results {
-		output[i] = &domain.FilteredCoordinate{
-			Idx: r.Idx,
-			Lat: r.Lat,
-			Lon: r.Lon,
+	return results, nil
+}
+
+// FilterCoordinatesByGeometry implements [ports.AdminAreaRepository].
+// The GeoJSON polygon is checked with ST_IsValid first so an invalid input is
+// reported with its reason instead of silently matching nothing.
+func (c *adminAreaRepository) FilterCoordinatesByGeometry(ctx context.Context, coordinates [][2]float64, geometry []byte) ([]*domain.FilteredCoordinate, error) {
+	if err := checkGeoJSONValid(ctx, c.db, geometry); err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`
+		WITH
+			boundary AS (
+				SELECT ST_SetSRID(ST_GeomFromGeoJSON(?), 4326) AS geom
+			),
+			input_coords(idx, lat, lon) AS (
+				VALUES %s
+			)
+		SELECT c.idx, c.lat, c.lon
+		FROM input_coords c, boundary b
+		WHERE ST_Contains(
+			b.geom,
+			ST_SetSRID(ST_MakePoint(c.lon, c.lat), 4326)
+		)
+		ORDER BY c.idx
+	`, coordinateValues(coordinates))
+
+	var results []*domain.FilteredCoordinate
+	if err := c.db.WithContext(ctx).Raw(sql, string(geometry)).Scan(&results).Error; err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ClipByBoundaries implements [ports.AdminAreaRepository].
+// The intersection with each area keeps only parts of the input's own dimension, so a
+// route running along a border is not reported as a stray point or line. Lengths and
+// areas are measured on geography, largest first.
+func (c *adminAreaRepository) ClipByBoundaries(ctx context.Context, geometry []byte, adminLevel int32) ([]*domain.ClippedArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := d.level(adminLevel)
+	if !ok {
+		return nil, errors.New("invalid admin level")
+	}
+	if err := checkGeoJSONValid(ctx, c.db, geometry); err != nil {
+		return nil, err
+	}
+
+	// OrderBy is the name column of the level
+	sql := fmt.Sprintf(`
+		WITH
+			input AS (
+				SELECT ST_SetSRID(ST_GeomFromGeoJSON(?), 4326) AS geom
+			),
+			clipped AS (
+				SELECT
+					a.gid_%d AS gid,
+					a.%s AS name,
+					ST_Dimension(i.geom) AS dim,
+					ST_CollectionExtract(ST_Intersection(a.geom, i.geom), ST_Dimension(i.geom) + 1) AS geom
+				FROM %s a, input i
+				WHERE ST_Intersects(a.geom, i.geom)
+			),
+			measured AS (
+				SELECT
+					gid,
+					name,
+					ST_AsGeoJSON(geom) AS geom,
+					CASE WHEN dim = 1 THEN ST_Length(geom::geography) / 1e3 END AS length_km,
+					CASE WHEN dim = 2 THEN ST_Area(geom::geography) / 1e6 END AS area_km2
+				FROM clipped
+				WHERE NOT ST_IsEmpty(geom)
+			)
+		SELECT * FROM measured
+		ORDER BY COALESCE(length_km, area_km2) DESC, gid
+	`, adminLevel, query.OrderBy, query.Table)
+
+	var rows []models.ClippedArea
+	if err := c.db.WithContext(ctx).Raw(sql, string(geometry)).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.ClippedArea, len(rows))
+	for i, row := range rows {
+		results[i] = row.ToDomain(adminLevel)
+	}
+	return results, nil
+}
+
+// LocateCoordinates implements [ports.AdminAreaRepository].
+// With a parent code only the areas below it are searched; the parent may be at any
+// higher level, e.g. a country when locating districts. Areas are measured once each.
+func (c *adminAreaRepository) LocateCoordinates(ctx context.Context, coordinates [][2]float64, adminLevel int32, parentCode *string) ([]*domain.CoordinateArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := d.level(adminLevel)
+	if !ok {
+		return nil, errors.New("invalid admin level")
+	}
+
+	parentClause, args := "TRUE", []any{}
+	if parentCode != nil {
+		parentLevel := int32(strings.Count(*parentCode, "."))
+		if parentLevel >= adminLevel {
+			return nil, fmt.Errorf("parent %s is not above admin level %d", *parentCode, adminLevel)
+		}
+		parentGID, err := resolveGID(ctx, c.db, d, *parentCode, parentLevel)
+		if err != nil {
+			return nil, err
 		}
+		parentClause, args = "a.gid_"+strconv.Itoa(int(parentLevel))+" = ?", []any{parentGID}
 	}
 
-	return output, nil
+	// OrderBy is the name column of the level
+	sql := fmt.Sprintf(`
+		WITH
+			input_coords(idx, lat, lon) AS (
+				VALUES %s
+			),
+			hits AS (
+				SELECT c.idx, a.ogc_fid
+				FROM input_coords c
+				JOIN %s a ON ST_Contains(a.geom, ST_SetSRID(ST_MakePoint(c.lon, c.lat), 4326))
+				WHERE %s
+			),
+			measured AS (
+				SELECT a.ogc_fid, a.gid_%d AS gid, a.%s AS name, ST_Area(a.geom::geography) / 1e6 AS area_km2
+				FROM %s a
+				WHERE a.ogc_fid IN (SELECT ogc_fid FROM hits)
+			)
+		SELECT h.idx, m.gid, m.name, m.area_km2
+		FROM hits h
+		JOIN measured m ON m.ogc_fid = h.ogc_fid
+		ORDER BY h.idx
+	`, coordinateValues(coordinates), query.Table, parentClause, adminLevel, query.OrderBy, query.Table)
+
+	var rows []models.CoordinateArea
+	if err := c.db.WithContext(ctx).Raw(sql, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.CoordinateArea, len(rows))
+	for i, row := range rows {
+		results[i] = row.ToDomain()
+	}
+	return results, nil
+}
+
+// checkGeoJSONValid parses a GeoJSON geometry with PostGIS and reports why it is invalid, if it is
+func checkGeoJSONValid(ctx context.Context, db *gorm.DB, geometry []byte) error {
+	var validity struct {
+		Valid  bool
+		Reason string
+	}
+	err := db.WithContext(ctx).Raw(
+		"SELECT ST_IsValid(g) AS valid, ST_IsValidReason(g) AS reason FROM (SELECT ST_GeomFromGeoJSON(?) AS g) s",
+		string(geometry),
+	).Scan(&validity).Error
+	if err != nil {
+		return fmt.Errorf("invalid geometry: %w", err)
+	}
+	if !validity.Valid {
+		return fmt.Errorf("invalid geometry: %s", validity.Reason)
+	}
+	return nil
+}
+
+// coordinateValues builds the rows of a VALUES clause for coordinates as (idx, lat, lon)
+func coordinateValues(coordinates [][2]float64) string {
+	valuesClauses := make([]string, len(coordinates))
+	for i, coord := range coordinates {
+		valuesClauses[i] = fmt.Sprintf("(%d, %f, %f)", i, coord[0], coord[1])
+	}
+	return strings.Join(valuesClauses, ", ")
+}
+
+// metricsSelect computes measurements on geography so areas and lengths are in
+// metres regardless of latitude; bbox and point-on-surface stay in degrees.
+const metricsSelect = `
+	ST_Area(geom::geography) / 1e6 AS area_km2,
+	ST_Perimeter(geom::geography) / 1e3 AS perimeter_km,
+	ST_Y(ST_Centroid(geom::geography)::geometry) AS centroid_lat,
+	ST_X(ST_Centroid(geom::geography)::geometry) AS centroid_lon,
+	ST_Y(ST_PointOnSurface(geom)) AS surface_lat,
+	ST_X(ST_PointOnSurface(geom)) AS surface_lon,
+	ST_XMin(geom) AS min_lon,
+	ST_YMin(geom) AS min_lat,
+	ST_XMax(geom) AS max_lon,
+	ST_YMax(geom) AS max_lat`
+
+// GetMetrics implements [ports.AdminAreaRepository].
+// The areas are measured in one query; ids without an area are left out of the result.
+func (c *adminAreaRepository) GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query, ok := d.level(adminLevel)
+	if !ok {
+		return nil, errors.New("invalid admin level")
+	}
+
+	var rows []models.AdminAreaMetrics
+	q := c.db.WithContext(ctx).Table(query.Table).Select("ogc_fid AS id,"+metricsSelect).Where("ogc_fid IN ?", ids)
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	metrics := make(map[int]*domain.AdminAreaMetrics, len(rows))
+	for _, row := range rows {
+		metrics[row.ID] = row.ToDomain()
+	}
+	return metrics, nil
+}
+
+// ListClippedToBBox implements [ports.AdminAreaRepository].
+// Only the part of each geometry inside the box is returned, so a small box over a
+// large area stays cheap to transfer.
+func (c *adminAreaRepository) ListClippedToBBox(ctx context.Context, adminLevel int32, bbox domain.BBox) ([]*domain.AdminArea, error) {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switch adminLevel {
+	case 0:
+		return listClippedToBBox[models.AdminArea0](c.db, d, ctx, adminLevel, bbox)
+	case 1:
+		return listClippedToBBox[models.AdminArea1](c.db, d, ctx, adminLevel, bbox)
+	case 2:
+		return listClippedToBBox[models.AdminArea2](c.db, d, ctx, adminLevel, bbox)
+	case 3:
+		return listClippedToBBox[models.AdminArea3](c.db, d, ctx, adminLevel, bbox)
+	case 4:
+		return listClippedToBBox[models.AdminArea4](c.db, d, ctx, adminLevel, bbox)
+	default:
+		return nil, errors.New("invalid admin level")
+	}
+}
+
+func listClippedToBBox[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, adminLevel int32, bbox domain.BBox) ([]*domain.AdminArea, error) {
+	query, _ := d.level(adminLevel)
+	envelope := "ST_MakeEnvelope(?, ?, ?, ?, 4326)"
+	selectClause := query.Select + ", ST_AsGeoJSON(ST_Multi(ST_CollectionExtract(ST_ClipByBox2D(geom, " + envelope + "), 3))) AS geom"
+	args := []any{bbox.MinLon, bbox.MinLat, bbox.MaxLon, bbox.MaxLat}
+
+	var adminAreas []T
+	q := db.WithContext(ctx).Table(query.Table).Select(selectClause, args...)
+	if err := q.Where("geom && "+envelope, args...).Order(query.OrderBy).Scan(&adminAreas).Error; err != nil {
+		return nil, err
+	}
+	result := models.MapAdminSliceToDomain(adminAreas)
+	d.stamp(result...)
+	return result, nil
 }
@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"gorm.io/gorm"
+)
+
+// simplifiedTable stores precomputed simplified geometries for every admin level,
+// keyed by simplification mode and tolerance.
+const simplifiedTable = "admin_simplified"
+
+// simplifiedExprs maps each mode to the expression used to precompute it.
+// ST_CoverageSimplify is a window function: running it over the whole level
+// simplifies shared edges once, so adjacent areas keep identical borders.
+var simplifiedExprs = map[domain.Simplification]string{
+	domain.SimplificationStandard: "ST_SimplifyPreserveTopology(geom, ?)",
+	domain.SimplificationCoverage: "ST_CoverageSimplify(geom, ?) OVER ()",
+}
+
+const insertSimplified = `
+INSERT INTO %s (admin_level, ogc_fid, mode, tolerance, geom)
+SELECT ?, ogc_fid, ?, ?, ST_Multi(%s)
+FROM %s`
+
+// simplifiedGeomSubquery returns a correlated subquery reading the precomputed geometry of the current row
+func simplifiedGeomSubquery(d dataset, table string, adminLevel int32, mode domain.Simplification, tolerance float64) string {
+	return fmt.Sprintf(
+		"(SELECT s.geom FROM %s s WHERE s.admin_level = %d AND s.mode = '%s' AND s.tolerance = %s AND s.ogc_fid = %s.ogc_fid)",
+		d.table(simplifiedTable), adminLevel, mode, strconv.FormatFloat(tolerance, 'g', -1, 64), table,
+	)
+}
+
+// checkSimplifiedLoaded reports a missing precomputation instead of returning areas without geometry
+func checkSimplifiedLoaded(areas []*domain.AdminArea, adminLevel int32, opts domain.GeometryOptions) error {
+	if opts.Omit || opts.Simplification != domain.SimplificationCoverage || opts.Tolerance == nil {
+		return nil
+	}
+	for _, area := range areas {
+		if len(area.Geometry) == 0 {
+			return fmt.Errorf("coverage simplification for admin level %d at tolerance %g has not been precomputed", adminLevel, *opts.Tolerance)
+		}
+	}
+	return nil
+}
+
+// PrecomputeSimplified implements [ports.AdminAreaRepository].
+// The rows for the level, mode and tolerance are replaced in a single transaction.
+func (c *adminAreaRepository) PrecomputeSimplified(ctx context.Context, adminLevel int32, mode domain.Simplification, tolerance float64) error {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	query, ok := d.level(adminLevel)
+	if !ok {
+		return errors.New("invalid admin level")
+	}
+	expr, ok := simplifiedExprs[mode]
+	if !ok {
+		return fmt.Errorf("unsupported simplification mode: %s", mode)
+	}
+
+	return c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM "+d.table(simplifiedTable)+" WHERE admin_level = ? AND mode = ? AND tolerance = ?", adminLevel, string(mode), tolerance).Error; err != nil {
+			return err
+		}
+		return tx.Exec(fmt.Sprintf(insertSimplified, d.table(simplifiedTable), expr, query.Table), adminLevel, string(mode), tolerance, tolerance).Error
+	})
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/repository/models"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"gorm.io/gorm"
+)
+
+// streamBatchSize is the number of rows fetched from the cursor per round trip
+const streamBatchSize = 500
+
+// Stream implements [ports.AdminAreaRepository].
+// Rows are read through a server-side cursor in batches so memory stays bounded even for whole levels.
+func (c *adminAreaRepository) Stream(ctx context.Context, scope domain.ExportScope, fn func(*domain.AdminArea) error) error {
+	d, err := c.datasets.resolve(ctx)
+	if err != nil {
+		return err
+	}
+	switch scope.AdminLevel {
+	case 0:
+		return stream[models.AdminArea0](c.db, d, ctx, scope, fn)
+	case 1:
+		return stream[models.AdminArea1](c.db, d, ctx, scope, fn)
+	case 2:
+		return stream[models.AdminArea2](c.db, d, ctx, scope, fn)
+	case 3:
+		return stream[models.AdminArea3](c.db, d, ctx, scope, fn)
+	case 4:
+		return stream[models.AdminArea4](c.db, d, ctx, scope, fn)
+	default:
+		return errors.New("invalid admin level")
+	}
+}
+
+func stream[T models.AdminArea](db *gorm.DB, d dataset, ctx context.Context, scope domain.ExportScope, fn func(*domain.AdminArea) error) error {
+	query, _ := d.level(scope.AdminLevel)
+	sql := "SELECT " + getSelectClause(d, scope.AdminLevel, scope.Geometry) + " FROM " + query.Table
+	var args []any
+	if scope.ParentCode != nil {
+		if scope.AdminLevel == 0 {
+			return errors.New("admin level 0 has no parent")
+		}
+		parentGID, err := resolveGID(ctx, db, d, *scope.ParentCode, scope.AdminLevel-1)
+		if err != nil {
+			return err
+		}
+		sql += " WHERE gid_" + strconv.Itoa(int(scope.AdminLevel-1)) + " = ?"
+		args = append(args, parentGID)
+	}
+	sql += " ORDER BY " + query.OrderBy
+
+	// Cursors only live inside a transaction
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DECLARE admin_export NO SCROLL CURSOR FOR "+sql, args...).Error; err != nil {
+			return err
+		}
+
+		fetch := fmt.Sprintf("FETCH %d FROM admin_export", streamBatchSize)
+		for {
+			var batch []T
+			if err := tx.Raw(fetch).Scan(&batch).Error; err != nil {
+				return err
+			}
+
+			areas := models.MapAdminSliceToDomain(batch)
+			d.stamp(areas...)
+			if err := checkSimplifiedLoaded(areas, scope.AdminLevel, scope.Geometry); err != nil {
+				return err
+			}
+			for _, area := range areas {
+				if err := fn(area); err != nil {
+					return err
+				}
+			}
+
+			if len(batch) < streamBatchSize {
+				return nil
+			}
+		}
+	})
+}
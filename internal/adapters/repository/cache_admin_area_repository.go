@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	"log"
+	"maps"
 
 	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
 	"github.com/hoshina-dev/gapi/internal/core/domain"
@@ -10,103 +12,196 @@ import (
 )
 
 type cacheAdminAreaRepository struct {
-	repo  ports.AdminAreaRepository
-	cache *infrastructure.Cache
+	cacheLoader
+	repo ports.AdminAreaRepository
 }
 
 func NewCacheAdminAreaRepository(repo ports.AdminAreaRepository, cache *infrastructure.Cache) ports.AdminAreaRepository {
-	return &cacheAdminAreaRepository{repo: repo, cache: cache}
+	return &cacheAdminAreaRepository{cacheLoader: cacheLoader{cache: cache}, repo: repo}
 }
 
 // GetByID implements ports.AdminAreaRepository.
-func (c *cacheAdminAreaRepository) GetByID(ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	cacheKey := c.generateCacheKey("admin_area", adminLevel, id, tolerance)
+func (c *cacheAdminAreaRepository) GetByID(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	cacheKey := c.generateCacheKey(domain.DatasetKey(ctx, "admin_area"), adminLevel, id, opts)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) (*domain.AdminArea, error) {
+		return c.repo.GetByID(ctx, id, adminLevel, opts)
+	})
+}
 
-	var adminArea domain.AdminArea
-	if c.cache.Get(ctx, cacheKey, &adminArea) {
-		return &adminArea, nil
-	}
+// List implements ports.AdminAreaRepository.
+func (c *cacheAdminAreaRepository) List(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	cacheKey := c.generateCacheKey(domain.DatasetKey(ctx, "admin_area:list"), adminLevel, opts)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.AdminArea, error) {
+		return c.repo.List(ctx, adminLevel, opts)
+	})
+}
 
-	// Cache miss: fetch from underlying repo
-	result, err := c.repo.GetByID(ctx, id, adminLevel, tolerance)
-	if err != nil {
-		return nil, err
-	}
+// GetByCode implements ports.AdminAreaRepository.
+func (c *cacheAdminAreaRepository) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	cacheKey := c.generateCacheKey(domain.DatasetKey(ctx, "admin_area:code"), adminLevel, code, opts)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) (*domain.AdminArea, error) {
+		return c.repo.GetByCode(ctx, code, adminLevel, opts)
+	})
+}
 
-	c.cache.Set(ctx, cacheKey, result)
-	return result, nil
+// GetChildren implements ports.AdminAreaRepository.
+func (c *cacheAdminAreaRepository) GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	cacheKey := c.generateCacheKey(domain.DatasetKey(ctx, "admin_area:children"), childLevel, parentCode, opts)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.AdminArea, error) {
+		return c.repo.GetChildren(ctx, parentCode, childLevel, opts)
+	})
 }
 
-// List implements ports.AdminAreaRepository.
-func (c *cacheAdminAreaRepository) List(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	cacheKey := c.generateCacheKey("admin_area:list", adminLevel, tolerance)
+// FilterCoordinatesByBoundary implements ports.AdminAreaRepository.
+// Note: We don't cache filtered coordinate results as they're too specific to be reused effectively.
+func (c *cacheAdminAreaRepository) FilterCoordinatesByBoundary(ctx context.Context, coordinates [][2]float64, boundaryID string, adminLevel int32) ([]*domain.FilteredCoordinate, error) {
+	// Pass through to underlying repository without caching
+	// Coordinate filtering results are too specific to cache effectively
+	return c.repo.FilterCoordinatesByBoundary(ctx, coordinates, boundaryID, adminLevel)
+}
 
-	var adminAreas []*domain.AdminArea
-	if c.cache.Get(ctx, cacheKey, &adminAreas) {
-		return adminAreas, nil
-	}
+// FilterCoordinatesByGeometry implements ports.AdminAreaRepository.
+// Input geometries are arbitrary, so results are not cached.
+func (c *cacheAdminAreaRepository) FilterCoordinatesByGeometry(ctx context.Context, coordinates [][2]float64, geometry []byte) ([]*domain.FilteredCoordinate, error) {
+	return c.repo.FilterCoordinatesByGeometry(ctx, coordinates, geometry)
+}
 
-	// Cache miss: fetch from underlying repo
-	result, err := c.repo.List(ctx, adminLevel, tolerance)
-	if err != nil {
-		return nil, err
-	}
+// ClipByBoundaries implements ports.AdminAreaRepository.
+// Input geometries are arbitrary, so results are not cached.
+func (c *cacheAdminAreaRepository) ClipByBoundaries(ctx context.Context, geometry []byte, adminLevel int32) ([]*domain.ClippedArea, error) {
+	return c.repo.ClipByBoundaries(ctx, geometry, adminLevel)
+}
 
-	c.cache.Set(ctx, cacheKey, result)
-	return result, nil
+// LocateCoordinates implements ports.AdminAreaRepository.
+// Coordinate sets are arbitrary, so results are not cached.
+func (c *cacheAdminAreaRepository) LocateCoordinates(ctx context.Context, coordinates [][2]float64, adminLevel int32, parentCode *string) ([]*domain.CoordinateArea, error) {
+	return c.repo.LocateCoordinates(ctx, coordinates, adminLevel, parentCode)
 }
 
-// GetByCode implements ports.AdminAreaRepository.
-func (c *cacheAdminAreaRepository) GetByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	cacheKey := c.generateCacheKey("admin_area:code", adminLevel, code, tolerance)
+// ListClippedToBBox implements ports.AdminAreaRepository.
+// Boxes are arbitrary, so results are cached by the callers that know their grid.
+func (c *cacheAdminAreaRepository) ListClippedToBBox(ctx context.Context, adminLevel int32, bbox domain.BBox) ([]*domain.AdminArea, error) {
+	return c.repo.ListClippedToBBox(ctx, adminLevel, bbox)
+}
 
-	var adminArea domain.AdminArea
-	if c.cache.Get(ctx, cacheKey, &adminArea) {
-		return &adminArea, nil
+// GetMetrics implements ports.AdminAreaRepository.
+// Metrics are computed on the full geometry, so the key does not depend on tolerance.
+// Each area has an entry of its own, and the areas missed are loaded in one query.
+// Stale entries are returned at once and reloaded together in the background.
+func (c *cacheAdminAreaRepository) GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error) {
+	metrics := make(map[int]*domain.AdminAreaMetrics, len(ids))
+	var missed, stale []int
+	for _, id := range ids {
+		var entry *domain.AdminAreaMetrics
+		found, isStale := c.cache.Lookup(ctx, c.metricsKey(ctx, adminLevel, id), &entry)
+		switch {
+		case !found:
+			missed = append(missed, id)
+		case isStale:
+			stale = append(stale, id)
+			fallthrough
+		default:
+			metrics[id] = entry
+		}
 	}
 
-	// Cache miss: fetch from underlying repo
-	result, err := c.repo.GetByCode(ctx, code, adminLevel, tolerance)
+	if len(stale) > 0 {
+		go func() {
+			if _, err := c.loadMetrics(context.WithoutCancel(ctx), stale, adminLevel); err != nil {
+				log.Printf("Failed to refresh cached metrics of admin level %d: %v", adminLevel, err)
+			}
+		}()
+	}
+	if len(missed) == 0 {
+		return metrics, nil
+	}
+	loaded, err := c.loadMetrics(ctx, missed, adminLevel)
 	if err != nil {
 		return nil, err
 	}
+	maps.Copy(metrics, loaded)
+	return metrics, nil
+}
 
-	c.cache.Set(ctx, cacheKey, result)
-	return result, nil
+// loadMetrics loads the metrics of the areas and caches them per area
+func (c *cacheAdminAreaRepository) loadMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error) {
+	metrics, err := c.repo.GetMetrics(ctx, ids, adminLevel)
+	if err != nil {
+		return nil, err
+	}
+	for id, entry := range metrics {
+		c.cache.Set(ctx, c.metricsKey(ctx, adminLevel, id), entry)
+	}
+	return metrics, nil
 }
 
-// GetChildren implements ports.AdminAreaRepository.
-func (c *cacheAdminAreaRepository) GetChildren(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	cacheKey := c.generateCacheKey("admin_area:children", childLevel, parentCode, tolerance)
+func (c *cacheAdminAreaRepository) metricsKey(ctx context.Context, adminLevel int32, id int) string {
+	return c.generateCacheKey(domain.DatasetKey(ctx, "admin_area:metrics"), adminLevel, id)
+}
 
-	var adminAreas []*domain.AdminArea
-	if c.cache.Get(ctx, cacheKey, &adminAreas) {
-		return adminAreas, nil
+// PrecomputeSimplified implements ports.AdminAreaRepository.
+// Cached coverage geometries are dropped afterwards so they are reloaded from the new precomputation;
+// standard presets produce the same result as on-the-fly simplification and stay cached.
+func (c *cacheAdminAreaRepository) PrecomputeSimplified(ctx context.Context, adminLevel int32, mode domain.Simplification, tolerance float64) error {
+	if err := c.repo.PrecomputeSimplified(ctx, adminLevel, mode, tolerance); err != nil {
+		return err
+	}
+	if mode != domain.SimplificationCoverage {
+		return nil
 	}
+	return c.cache.DeletePattern(ctx, "admin_area*:coverage:*")
+}
 
-	// Cache miss: fetch from underlying repo
-	result, err := c.repo.GetChildren(ctx, parentCode, childLevel, tolerance)
-	if err != nil {
-		return nil, err
+// GetNeighbors implements ports.AdminAreaRepository.
+func (c *cacheAdminAreaRepository) GetNeighbors(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminAreaNeighbor, error) {
+	cacheKey := c.generateCacheKey(domain.DatasetKey(ctx, "admin_area:neighbors"), adminLevel, id, opts)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.AdminAreaNeighbor, error) {
+		return c.repo.GetNeighbors(ctx, id, adminLevel, opts)
+	})
+}
+
+// PrecomputeAdjacency implements ports.AdminAreaRepository.
+// Cached neighbours of the level in the dataset are dropped afterwards so they are reloaded from the new table.
+func (c *cacheAdminAreaRepository) PrecomputeAdjacency(ctx context.Context, adminLevel int32) error {
+	if err := c.repo.PrecomputeAdjacency(ctx, adminLevel); err != nil {
+		return err
 	}
+	return c.cache.DeletePattern(ctx, fmt.Sprintf("%s:%d:*", domain.DatasetKey(ctx, "admin_area:neighbors"), adminLevel))
+}
 
-	c.cache.Set(ctx, cacheKey, result)
-	return result, nil
+// Crosswalk implements ports.AdminAreaRepository.
+// Released datasets do not change, so matches are cached like the areas themselves, under
+// the namespace versions of both datasets so that re-importing either one misses them.
+func (c *cacheAdminAreaRepository) Crosswalk(ctx context.Context, code string, adminLevel int32, from, to string, opts domain.GeometryOptions) ([]*domain.CrosswalkMatch, error) {
+	fromVersion := c.cache.Version(domain.WithDataset(ctx, from))
+	toVersion := c.cache.Version(domain.WithDataset(ctx, to))
+	cacheKey := c.generateCacheKey("admin_area:crosswalk", from, fromVersion, to, toVersion, adminLevel, code, opts)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.CrosswalkMatch, error) {
+		return c.repo.Crosswalk(ctx, code, adminLevel, from, to, opts)
+	})
 }
 
-// FilterCoordinatesByBoundary implements ports.AdminAreaRepository.
-// Note: We don't cache filtered coordinate results as they're too specific to be reused effectively.
-func (c *cacheAdminAreaRepository) FilterCoordinatesByBoundary(ctx context.Context, coordinates [][2]float64, boundaryID string, adminLevel int32) ([]*domain.FilteredCoordinate, error) {
-	// Pass through to underlying repository without caching
-	// Coordinate filtering results are too specific to cache effectively
-	return c.repo.FilterCoordinatesByBoundary(ctx, coordinates, boundaryID, adminLevel)
+// Stream implements ports.AdminAreaRepository.
+// Streams are exports of whole levels and bypass the cache.
+func (c *cacheAdminAreaRepository) Stream(ctx context.Context, scope domain.ExportScope, fn func(*domain.AdminArea) error) error {
+	return c.repo.Stream(ctx, scope, fn)
 }
 
-// generateCacheKey creates a consistent cache key by properly formatting the tolerance pointer
+// generateCacheKey creates a consistent cache key by properly formatting the tolerance pointer.
+// Options that omit geometry share a single ":nogeom" entry since tolerance is irrelevant.
 func (c *cacheAdminAreaRepository) generateCacheKey(prefix string, parts ...interface{}) string {
 	key := prefix
 	for _, part := range parts {
 		switch v := part.(type) {
+		case domain.GeometryOptions:
+			switch {
+			case v.Omit:
+				key += ":nogeom"
+			case v.Simplification == domain.SimplificationCoverage:
+				key = c.generateCacheKey(key+":coverage", v.Tolerance)
+			default:
+				key = c.generateCacheKey(key, v.Tolerance)
+			}
 		case *float64:
 			if v == nil {
 				key += ":<nil>"
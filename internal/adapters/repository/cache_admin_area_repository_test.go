@@ -1,7 +1,18 @@
 package repository
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
 )
 
 func TestGenerateCacheKey(t *testing.T) {
@@ -43,6 +54,30 @@ func TestGenerateCacheKey(t *testing.T) {
 			parts:    []interface{}{int32(1), "TH", floatPtr(0.001)},
 			expected: "admin_area:code:1:TH:0.0010000000",
 		},
+		{
+			name:     "metrics without tolerance",
+			prefix:   "admin_area:metrics",
+			parts:    []interface{}{int32(2), 42},
+			expected: "admin_area:metrics:2:42",
+		},
+		{
+			name:     "geometry options with tolerance",
+			prefix:   "admin_area:list",
+			parts:    []interface{}{int32(1), domain.GeometryOptions{Tolerance: floatPtr(0.001)}},
+			expected: "admin_area:list:1:0.0010000000",
+		},
+		{
+			name:     "geometry omitted ignores tolerance",
+			prefix:   "admin_area:list",
+			parts:    []interface{}{int32(1), domain.GeometryOptions{Tolerance: floatPtr(0.001), Omit: true}},
+			expected: "admin_area:list:1:nogeom",
+		},
+		{
+			name:     "coverage simplification",
+			prefix:   "admin_area:children",
+			parts:    []interface{}{int32(2), "THA.1_1", domain.GeometryOptions{Tolerance: floatPtr(0.01), Simplification: domain.SimplificationCoverage}},
+			expected: "admin_area:children:2:THA.1_1:coverage:0.0100000000",
+		},
 	}
 
 	for _, tt := range tests {
@@ -58,3 +93,140 @@ func TestGenerateCacheKey(t *testing.T) {
 func floatPtr(f float64) *float64 {
 	return &f
 }
+
+// countingRepo counts the levels it lists, blocking each call until release is closed
+type countingRepo struct {
+	ports.AdminAreaRepository
+	calls   atomic.Int32
+	release chan struct{}
+	err     error
+}
+
+func (r *countingRepo) List(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	call := r.calls.Add(1)
+	<-r.release
+	if r.err != nil {
+		return nil, r.err
+	}
+	return []*domain.AdminArea{{Name: fmt.Sprintf("call %d", call), AdminLevel: adminLevel}}, nil
+}
+
+func newTestCachedRepo(repo ports.AdminAreaRepository, cfg infrastructure.Config) ports.AdminAreaRepository {
+	cfg.CacheMemoryMB = 1
+	return NewCacheAdminAreaRepository(repo, infrastructure.NewCache(nil, cfg))
+}
+
+func TestCachedCoalescesMisses(t *testing.T) {
+	inner := &countingRepo{release: make(chan struct{})}
+	repo := newTestCachedRepo(inner, infrastructure.Config{CacheTTL: time.Hour})
+
+	var wg sync.WaitGroup
+	results := make([][]*domain.AdminArea, 50)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], _ = repo.List(context.Background(), 3, domain.GeometryOptions{})
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(inner.release)
+	wg.Wait()
+
+	if calls := inner.calls.Load(); calls != 1 {
+		t.Errorf("List was loaded %d times, want once", calls)
+	}
+	for i, areas := range results {
+		if len(areas) != 1 || areas[0].Name != "call 1" {
+			t.Fatalf("request %d got %v, want the shared load", i, areas)
+		}
+	}
+}
+
+func TestCachedDoesNotCacheErrors(t *testing.T) {
+	inner := &countingRepo{release: make(chan struct{}), err: errors.New("database down")}
+	close(inner.release)
+	repo := newTestCachedRepo(inner, infrastructure.Config{CacheTTL: time.Hour})
+
+	for range 2 {
+		if _, err := repo.List(context.Background(), 1, domain.GeometryOptions{}); err == nil {
+			t.Fatal("List() succeeded, want the error of the repository")
+		}
+	}
+	if calls := inner.calls.Load(); calls != 2 {
+		t.Errorf("List was loaded %d times, want every failed request retried", calls)
+	}
+}
+
+func TestCachedServesStaleEntriesWhileRefreshing(t *testing.T) {
+	inner := &countingRepo{release: make(chan struct{})}
+	close(inner.release)
+	repo := newTestCachedRepo(inner, infrastructure.Config{CacheTTL: 10 * time.Millisecond, CacheStaleTTL: time.Hour})
+	ctx := context.Background()
+
+	repo.List(ctx, 1, domain.GeometryOptions{})
+	time.Sleep(20 * time.Millisecond)
+	stale, err := repo.List(ctx, 1, domain.GeometryOptions{})
+
+	if err != nil || len(stale) != 1 || stale[0].Name != "call 1" {
+		t.Fatalf("List() = %v, %v, want the stale entry", stale, err)
+	}
+	deadline := time.Now().Add(time.Second)
+	for inner.calls.Load() < 2 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if calls := inner.calls.Load(); calls != 2 {
+		t.Fatalf("List was loaded %d times, want the stale entry refreshed", calls)
+	}
+	// The refresh stores the entry just after loading it
+	deadline = time.Now().Add(time.Second)
+	for {
+		fresh, _ := repo.List(ctx, 1, domain.GeometryOptions{})
+		if fresh[0].Name == "call 2" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("List() = %v after the refresh, want the refreshed entry", fresh)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// metricsRepo records the ids of each metrics query
+type metricsRepo struct {
+	ports.AdminAreaRepository
+	queries [][]int
+}
+
+func (r *metricsRepo) GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error) {
+	r.queries = append(r.queries, ids)
+	metrics := make(map[int]*domain.AdminAreaMetrics, len(ids))
+	for _, id := range ids {
+		// Area 9 does not exist
+		if id != 9 {
+			metrics[id] = &domain.AdminAreaMetrics{AreaKm2: float64(id)}
+		}
+	}
+	return metrics, nil
+}
+
+func TestGetMetricsLoadsMissedAreasTogether(t *testing.T) {
+	inner := &metricsRepo{}
+	repo := newTestCachedRepo(inner, infrastructure.Config{CacheTTL: time.Hour})
+	ctx := context.Background()
+
+	if _, err := repo.GetMetrics(ctx, []int{1, 2, 3}, 2); err != nil {
+		t.Fatalf("GetMetrics() error = %v", err)
+	}
+	metrics, err := repo.GetMetrics(ctx, []int{2, 3, 4, 9}, 2)
+	if err != nil {
+		t.Fatalf("GetMetrics() error = %v", err)
+	}
+
+	if len(inner.queries) != 2 || !slices.Equal(inner.queries[0], []int{1, 2, 3}) || !slices.Equal(inner.queries[1], []int{4, 9}) {
+		t.Errorf("queries = %v, want [1 2 3] then only the missed [4 9]", inner.queries)
+	}
+	if len(metrics) != 3 || metrics[2].AreaKm2 != 2 || metrics[4].AreaKm2 != 4 {
+		t.Errorf("metrics = %v, want areas 2, 3 and 4", metrics)
+	}
+}
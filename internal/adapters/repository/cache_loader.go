@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"log"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"golang.org/x/sync/singleflight"
+)
+
+// cacheLoader loads the entries of the caching decorators, coalescing their misses
+type cacheLoader struct {
+	cache *infrastructure.Cache
+	loads singleflight.Group
+}
+
+// cached returns the entry of cacheKey, loading it with load on a miss. Concurrent misses
+// of a key share a single load, and with distributed locks the other instances wait for
+// its entry instead of loading it too. Stale entries are returned at once and refreshed
+// in the background.
+func cached[T any](ctx context.Context, l *cacheLoader, cacheKey string, load func(context.Context) (T, error)) (T, error) {
+	var value T
+	found, stale := l.cache.Lookup(ctx, cacheKey, &value)
+	if found {
+		if stale {
+			l.loads.DoChan("refresh "+cacheKey, func() (any, error) {
+				return nil, refresh(context.WithoutCancel(ctx), l, cacheKey, load)
+			})
+		}
+		return value, nil
+	}
+
+	// Cache miss: load once for every waiting request, even if the first one is cancelled
+	result, err, _ := l.loads.Do(cacheKey, func() (any, error) {
+		return fill(context.WithoutCancel(ctx), l, cacheKey, load)
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return result.(T), nil
+}
+
+// fill loads a missed entry and caches it, or waits for the instance holding its lock
+func fill[T any](ctx context.Context, l *cacheLoader, cacheKey string, load func(context.Context) (T, error)) (T, error) {
+	unlock, acquired := l.cache.Lock(ctx, cacheKey)
+	if acquired {
+		defer unlock()
+	} else {
+		var value T
+		if l.cache.Await(ctx, cacheKey, &value) {
+			return value, nil
+		}
+		// The other instance failed or took too long: load the entry here after all
+	}
+
+	result, err := load(ctx)
+	if err != nil {
+		return result, err
+	}
+	l.cache.Set(ctx, cacheKey, result)
+	return result, nil
+}
+
+// refresh reloads a stale entry, unless another instance holds its lock and does it
+func refresh[T any](ctx context.Context, l *cacheLoader, cacheKey string, load func(context.Context) (T, error)) error {
+	unlock, acquired := l.cache.Lock(ctx, cacheKey)
+	if !acquired {
+		return nil
+	}
+	defer unlock()
+
+	result, err := load(ctx)
+	if err != nil {
+		log.Printf("Failed to refresh cached %s: %v", cacheKey, err)
+		return err
+	}
+	l.cache.Set(ctx, cacheKey, result)
+	return nil
+}
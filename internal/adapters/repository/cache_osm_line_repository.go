@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/geo"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// nearbyCoordinateStep is the grid nearby searches are snapped to, in degrees: about
+// 11 m at the equator, well under the radius of a useful search
+const nearbyCoordinateStep = 1e-4
+
+type cacheOSMLineRepository struct {
+	cacheLoader
+	repo ports.OSMLineRepository
+}
+
+// NewCacheOSMLineRepository caches road searches under "osm_line" keys, whose TTL
+// defaults to a few minutes (see CACHE_TTLS).
+func NewCacheOSMLineRepository(repo ports.OSMLineRepository, cache *infrastructure.Cache) ports.OSMLineRepository {
+	return &cacheOSMLineRepository{cacheLoader: cacheLoader{cache: cache}, repo: repo}
+}
+
+// SearchRoadName implements ports.OSMLineRepository.
+// Terms differing only in case or spacing share an entry and are searched as normalized.
+func (c *cacheOSMLineRepository) SearchRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.OSMLine, error) {
+	searchTerm = normalizeSearchTerm(searchTerm)
+	cacheKey := fmt.Sprintf("osm_line:search:%d:%s", limit, searchTerm)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.OSMLine, error) {
+		return c.repo.SearchRoadName(ctx, searchTerm, limit)
+	})
+}
+
+// GetAddressByRoadName implements ports.OSMLineRepository.
+func (c *cacheOSMLineRepository) GetAddressByRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.LineWithAddress, error) {
+	searchTerm = normalizeSearchTerm(searchTerm)
+	cacheKey := fmt.Sprintf("osm_line:address:%d:%s", limit, searchTerm)
+	return cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.LineWithAddress, error) {
+		return c.repo.GetAddressByRoadName(ctx, searchTerm, limit)
+	})
+}
+
+// FindNearbyRoads implements ports.OSMLineRepository.
+// Points are snapped to nearbyCoordinateStep so that searches around a hotspot share an
+// entry. The shared search is widened by the most a point moves when snapped, and its
+// roads are narrowed down to the radius around the point asked for and reordered by
+// their distance to it. Which roads make the limit can still differ from an unsnapped
+// search among those a few metres apart.
+func (c *cacheOSMLineRepository) FindNearbyRoads(ctx context.Context, lat float64, lon float64, radius float64, limit int) ([]*domain.OSMLine, error) {
+	snappedLat, snappedLon := quantizeCoordinate(lat), quantizeCoordinate(lon)
+	cacheKey := fmt.Sprintf("osm_line:nearby:%.4f:%.4f:%g:%d", snappedLat, snappedLon, radius, limit)
+	lines, err := cached(ctx, &c.cacheLoader, cacheKey, func(ctx context.Context) ([]*domain.OSMLine, error) {
+		return c.repo.FindNearbyRoads(ctx, snappedLat, snappedLon, radius+snapMargin(snappedLat), limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return withinRadius(lines, lat, lon, radius), nil
+}
+
+// snapMargin is the most a point around the given snapped latitude is moved when
+// snapped, half the diagonal of a grid cell, in the Web Mercator metres of the radius
+func snapMargin(lat float64) float64 {
+	half := nearbyCoordinateStep / 2
+	// Cells stretch away from the equator, so the corner on that side is the furthest
+	centre := geo.WebMercator(geo.Point{0, lat})
+	corner := geo.WebMercator(geo.Point{half, lat + math.Copysign(half, lat)})
+	return math.Hypot(corner[0]-centre[0], corner[1]-centre[1])
+}
+
+// withinRadius returns the lines within the radius of the point, nearest first, in a
+// new slice as the given one may be cached. A line whose geometry cannot be read is
+// kept, after the others.
+func withinRadius(lines []*domain.OSMLine, lat, lon, radius float64) []*domain.OSMLine {
+	point := geo.WebMercator(geo.Point{lon, lat})
+	distances := make(map[*domain.OSMLine]float64, len(lines))
+	kept := make([]*domain.OSMLine, 0, len(lines))
+	for _, line := range lines {
+		distance := radius
+		if g, err := geo.ParseGeometry(line.Geometry); err == nil {
+			projected := geo.Geometry{Lines: make([]geo.LineString, len(g.Lines))}
+			for i, part := range g.Lines {
+				projected.Lines[i] = make(geo.LineString, len(part))
+				for j, p := range part {
+					projected.Lines[i][j] = geo.WebMercator(p)
+				}
+			}
+			distance = projected.LineDistance(point)
+		}
+		if distance <= radius {
+			distances[line] = distance
+			kept = append(kept, line)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return distances[kept[i]] < distances[kept[j]] })
+	return kept
+}
+
+// normalizeSearchTerm lowercases a term and collapses its whitespace. Road names are
+// matched with ILIKE and trigram similarity, which both ignore case.
+func normalizeSearchTerm(term string) string {
+	return strings.ToLower(strings.Join(strings.Fields(term), " "))
+}
+
+// quantizeCoordinate snaps a coordinate to the nearest multiple of nearbyCoordinateStep
+func quantizeCoordinate(v float64) float64 {
+	return math.Round(v/nearbyCoordinateStep) * nearbyCoordinateStep
+}
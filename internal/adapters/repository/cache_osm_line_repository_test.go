@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/infrastructure"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// recordingOSMLineRepo records the arguments of the searches reaching the database
+type recordingOSMLineRepo struct {
+	ports.OSMLineRepository
+	terms  []string
+	points [][2]float64
+	radii  []float64
+	// nearby are the roads nearby searches find, a line at the searched point when nil
+	nearby []*domain.OSMLine
+}
+
+func (r *recordingOSMLineRepo) SearchRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.OSMLine, error) {
+	r.terms = append(r.terms, searchTerm)
+	return []*domain.OSMLine{{Name: &searchTerm}}, nil
+}
+
+func (r *recordingOSMLineRepo) FindNearbyRoads(ctx context.Context, lat float64, lon float64, radius float64, limit int) ([]*domain.OSMLine, error) {
+	r.points = append(r.points, [2]float64{lat, lon})
+	r.radii = append(r.radii, radius)
+	if r.nearby != nil {
+		return r.nearby, nil
+	}
+	return []*domain.OSMLine{{Centroid: domain.Coordinate{Lat: lat, Lon: lon}}}, nil
+}
+
+func newTestCachedOSMLineRepo(inner ports.OSMLineRepository) ports.OSMLineRepository {
+	return NewCacheOSMLineRepository(inner, infrastructure.NewCache(nil, infrastructure.Config{CacheTTL: time.Hour, CacheMemoryMB: 1}))
+}
+
+func TestNormalizeSearchTerm(t *testing.T) {
+	tests := []struct {
+		term string
+		want string
+	}{
+		{term: "Sukhumvit", want: "sukhumvit"},
+		{term: "  Sukhumvit   Road ", want: "sukhumvit road"},
+		{term: "ถนน\tสุขุมวิท", want: "ถนน สุขุมวิท"},
+		{term: "   ", want: ""},
+	}
+	for _, tt := range tests {
+		if got := normalizeSearchTerm(tt.term); got != tt.want {
+			t.Errorf("normalizeSearchTerm(%q) = %q, want %q", tt.term, got, tt.want)
+		}
+	}
+}
+
+func TestCacheOSMLineRepository_SearchSharesNormalizedTerms(t *testing.T) {
+	inner := &recordingOSMLineRepo{}
+	repo := newTestCachedOSMLineRepo(inner)
+	ctx := context.Background()
+
+	for _, term := range []string{"Sukhumvit Road", "sukhumvit  road", " SUKHUMVIT ROAD"} {
+		lines, err := repo.SearchRoadName(ctx, term, 10)
+		if err != nil || len(lines) != 1 {
+			t.Fatalf("SearchRoadName(%q) = %v, %v", term, lines, err)
+		}
+	}
+	repo.SearchRoadName(ctx, "Sukhumvit Road", 20)
+
+	if len(inner.terms) != 2 || inner.terms[0] != "sukhumvit road" {
+		t.Errorf("searched %q, want the normalized term once per limit", inner.terms)
+	}
+}
+
+func TestCacheOSMLineRepository_NearbySharesQuantizedPoints(t *testing.T) {
+	inner := &recordingOSMLineRepo{}
+	repo := newTestCachedOSMLineRepo(inner)
+	ctx := context.Background()
+
+	repo.FindNearbyRoads(ctx, 13.736717, 100.523186, 500, 10)
+	repo.FindNearbyRoads(ctx, 13.73673, 100.52321, 500, 10)
+	repo.FindNearbyRoads(ctx, 13.7372, 100.523186, 500, 10)
+
+	if len(inner.points) != 2 {
+		t.Fatalf("searched %v, want points in the same grid cell to share an entry", inner.points)
+	}
+	if got := inner.points[0]; got[0] != quantizeCoordinate(13.736717) || got[1] != quantizeCoordinate(100.523186) {
+		t.Errorf("searched around %v, want the snapped point", got)
+	}
+}
+
+func TestCacheOSMLineRepository_NearbyMeasuresFromTheGivenPoint(t *testing.T) {
+	lat, lon := 13.73672, 100.52318
+	// A north-south road east of the point, whose distance in Web Mercator metres is the offset
+	road := func(name string, metres float64) *domain.OSMLine {
+		x := lon + metres/6378137*180/math.Pi
+		geometry := fmt.Sprintf(`{"type":"LineString","coordinates":[[%f,%f],[%f,%f]]}`, x, lat-0.01, x, lat+0.01)
+		return &domain.OSMLine{Name: &name, Geometry: []byte(geometry)}
+	}
+	inner := &recordingOSMLineRepo{nearby: []*domain.OSMLine{road("outside", 503), road("inside", 497), road("near", 10)}}
+	repo := newTestCachedOSMLineRepo(inner)
+
+	lines, err := repo.FindNearbyRoads(context.Background(), lat, lon, 500, 10)
+	if err != nil {
+		t.Fatalf("FindNearbyRoads() error = %v", err)
+	}
+	if len(inner.radii) != 1 || inner.radii[0] <= 500 || inner.radii[0] > 520 {
+		t.Errorf("searched the snapped point within %v, want the radius widened by the snapping", inner.radii)
+	}
+	var names []string
+	for _, line := range lines {
+		names = append(names, *line.Name)
+	}
+	if fmt.Sprint(names) != "[near inside]" {
+		t.Errorf("roads = %v, want [near inside]", names)
+	}
+}
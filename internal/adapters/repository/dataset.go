@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"gorm.io/gorm"
+)
+
+// Datasets lists the GADM releases loaded side by side. Each release lives in the
+// schema of its name with the same tables; the default may be the unqualified tables.
+type Datasets struct {
+	known       map[string]bool
+	defaultName string
+}
+
+func NewDatasets(names []string, defaultName string) Datasets {
+	known := make(map[string]bool, len(names))
+	for _, name := range names {
+		known[name] = true
+	}
+	return Datasets{known: known, defaultName: defaultName}
+}
+
+// resolve returns the dataset selected for the request, see [domain.WithDataset]
+func (ds Datasets) resolve(ctx context.Context) (dataset, error) {
+	return ds.named(domain.DatasetFromContext(ctx))
+}
+
+// named returns the dataset of the given name, or the default one for ""
+func (ds Datasets) named(name string) (dataset, error) {
+	if name == "" {
+		name = ds.defaultName
+	}
+	if name != "" && !ds.known[name] {
+		return dataset{}, fmt.Errorf("unknown dataset: %s", name)
+	}
+	return dataset{name: name}, nil
+}
+
+// dataset is one GADM release; its name is also its schema
+type dataset struct {
+	name string
+}
+
+// table qualifies a table name with the schema of the dataset
+func (d dataset) table(name string) string {
+	if d.name == "" {
+		return name
+	}
+	return d.name + "." + name
+}
+
+// level returns the query of an admin level with its table in the dataset
+func (d dataset) level(adminLevel int32) (levelQuery, bool) {
+	query, ok := queries[adminLevel]
+	query.Table = d.table(query.Table)
+	return query, ok
+}
+
+// stamp records the dataset on areas read from it, so fields resolved later read the same release
+func (d dataset) stamp(areas ...*domain.AdminArea) {
+	for _, area := range areas {
+		area.Dataset = d.name
+	}
+}
+
+// resolveGID returns the GID of the area of a level matching a code. GADM suffixes the
+// codes below the country with a version, e.g. "THA.1_1". A code without the suffix
+// must match a single version in the dataset: a release carrying several is an error
+// rather than an arbitrary pick.
+func resolveGID(ctx context.Context, db *gorm.DB, d dataset, code string, adminLevel int32) (string, error) {
+	query, ok := d.level(adminLevel)
+	if !ok {
+		return "", fmt.Errorf("invalid admin level for code %s", code)
+	}
+	if adminLevel == 0 || strings.Contains(code, "_") {
+		return code, nil
+	}
+
+	gidColumn := "gid_" + strconv.Itoa(int(adminLevel))
+	var gids []string
+	err := db.WithContext(ctx).Table(query.Table).
+		Distinct(gidColumn).
+		Where(gidColumn+" LIKE ? ESCAPE '\\'", escapeLike(code)+`\_%`).
+		Order(gidColumn).
+		Limit(2).
+		Pluck(gidColumn, &gids).Error
+	if err != nil {
+		return "", err
+	}
+
+	switch len(gids) {
+	case 0:
+		return "", fmt.Errorf("%w: %s", domain.ErrBoundaryNotFound, code)
+	case 1:
+		return gids[0], nil
+	default:
+		return "", fmt.Errorf("code %s is ambiguous, it matches %s; include the version suffix", code, strings.Join(gids, ", "))
+	}
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+)
+
+func TestDatasetsResolve(t *testing.T) {
+	datasets := NewDatasets([]string{"gadm36", "gadm41"}, "gadm41")
+
+	tests := []struct {
+		name      string
+		selected  string
+		wantTable string
+		wantErr   bool
+	}{
+		{"default", "", "gadm41.admin1", false},
+		{"selected", "gadm36", "gadm36.admin1", false},
+		{"unknown", "gadm28", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := datasets.resolve(domain.WithDataset(context.Background(), tt.selected))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			query, ok := d.level(1)
+			if !ok || query.Table != tt.wantTable {
+				t.Errorf("level(1).Table = %q, want %q", query.Table, tt.wantTable)
+			}
+		})
+	}
+
+	// Without a default dataset the unqualified tables are read
+	d, err := NewDatasets(nil, "").resolve(context.Background())
+	if err != nil {
+		t.Fatalf("resolve() error = %v", err)
+	}
+	if query, _ := d.level(0); query.Table != "admin0" {
+		t.Errorf("level(0).Table = %q, want admin0", query.Table)
+	}
+	if queries[1].Table != "admin1" {
+		t.Error("level() must not qualify the shared queries")
+	}
+}
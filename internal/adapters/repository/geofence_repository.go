@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hoshina-dev/gapi/internal/adapters/repository/models"
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+	"gorm.io/gorm"
+)
+
+// geofencesTable stores custom zones, see the migrations
+const geofencesTable = "geofences"
+
+type geofenceRepository struct {
+	db       *gorm.DB
+	datasets Datasets
+}
+
+// NewGeofenceRepository stores custom zones in the geofences table created by the
+// migrations. Geofences built from GADM boundaries keep their codes so clients can see
+// how they were defined, but the union is stored so point tests never touch the admin tables.
+func NewGeofenceRepository(db *gorm.DB, datasets Datasets) ports.GeofenceRepository {
+	return &geofenceRepository{db: db, datasets: datasets}
+}
+
+// Create implements [ports.GeofenceRepository].
+func (r *geofenceRepository) Create(ctx context.Context, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	geomExpr, geomArgs, err := r.geometryExpr(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := json.Marshal(input.BoundaryCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`
+		INSERT INTO geofences (name, description, boundary_codes, geom)
+		VALUES (?, ?, ?::jsonb, %s)
+		RETURNING id
+	`, geomExpr)
+	args := append([]any{input.Name, input.Description, string(codes)}, geomArgs...)
+
+	var id int
+	if err := r.db.WithContext(ctx).Raw(sql, args...).Scan(&id).Error; err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id, domain.GeometryOptions{})
+}
+
+// Update implements [ports.GeofenceRepository]. The geofence is replaced as a whole,
+// recomputing the union when it is defined by boundary codes.
+func (r *geofenceRepository) Update(ctx context.Context, id int, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	geomExpr, geomArgs, err := r.geometryExpr(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := json.Marshal(input.BoundaryCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	sql := fmt.Sprintf(`
+		UPDATE geofences
+		SET name = ?, description = ?, boundary_codes = ?::jsonb, geom = %s, updated_at = now()
+		WHERE id = ?
+	`, geomExpr)
+	args := append([]any{input.Name, input.Description, string(codes)}, geomArgs...)
+
+	result := r.db.WithContext(ctx).Exec(sql, append(args, id)...)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, fmt.Errorf("geofence not found: %d", id)
+	}
+	return r.GetByID(ctx, id, domain.GeometryOptions{})
+}
+
+// Delete implements [ports.GeofenceRepository].
+func (r *geofenceRepository) Delete(ctx context.Context, id int) error {
+	result := r.db.WithContext(ctx).Exec("DELETE FROM geofences WHERE id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("geofence not found: %d", id)
+	}
+	return nil
+}
+
+// GetByID implements [ports.GeofenceRepository].
+func (r *geofenceRepository) GetByID(ctx context.Context, id int, opts domain.GeometryOptions) (*domain.Geofence, error) {
+	var geofence models.Geofence
+	err := r.db.WithContext(ctx).Table(geofencesTable).Select(geofenceSelect(opts)).Where("id = ?", id).Take(&geofence).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("geofence not found: %d", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return geofence.ToDomain(), nil
+}
+
+// List implements [ports.GeofenceRepository].
+func (r *geofenceRepository) List(ctx context.Context, opts domain.GeometryOptions) ([]*domain.Geofence, error) {
+	var geofences []models.Geofence
+	if err := r.db.WithContext(ctx).Table(geofencesTable).Select(geofenceSelect(opts)).Order("name, id").Find(&geofences).Error; err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Geofence, len(geofences))
+	for i, g := range geofences {
+		result[i] = g.ToDomain()
+	}
+	return result, nil
+}
+
+// Match implements [ports.GeofenceRepository]. Geofences are keyed by the index of
+// the coordinate they contain; coordinates outside every geofence have no entry.
+func (r *geofenceRepository) Match(ctx context.Context, coordinates [][2]float64, opts domain.GeometryOptions) (map[int][]*domain.Geofence, error) {
+	// Note: ST_MakePoint takes (lon, lat) not (lat, lon)!
+	sql := fmt.Sprintf(`
+		WITH input_coords(idx, lat, lon) AS (
+			VALUES %s
+		)
+		SELECT c.idx, %s
+		FROM input_coords c
+		JOIN geofences ON ST_Contains(
+			geofences.geom,
+			ST_SetSRID(ST_MakePoint(c.lon, c.lat), 4326)
+		)
+		ORDER BY c.idx, geofences.name, geofences.id
+	`, coordinateValues(coordinates), geofenceSelect(opts))
+
+	var rows []models.GeofenceMatch
+	if err := r.db.WithContext(ctx).Raw(sql).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	result := make(map[int][]*domain.Geofence)
+	for _, row := range rows {
+		result[row.Idx] = append(result[row.Idx], row.Geofence.ToDomain())
+	}
+	return result, nil
+}
+
+// geofenceSelect lists the geofence columns, serializing geometry only when it is requested
+func geofenceSelect(opts domain.GeometryOptions) string {
+	columns := "id, name, description, boundary_codes, created_at, updated_at"
+	if opts.Omit {
+		return columns
+	}
+	if opts.Tolerance != nil && *opts.Tolerance > 0 {
+		return columns + fmt.Sprintf(", ST_AsGeoJSON(ST_SimplifyPreserveTopology(geom, %f)) AS geom", *opts.Tolerance)
+	}
+	return columns + ", ST_AsGeoJSON(geom) AS geom"
+}
+
+// geometryExpr returns the SQL expression producing the geofence MultiPolygon: the
+// validated input GeoJSON, or the union of the GADM boundaries. Every boundary code
+// must exist in the dataset of the request; its admin level is the number of
+// dot-separated parts after the country.
+func (r *geofenceRepository) geometryExpr(ctx context.Context, input domain.GeofenceDefinition) (string, []any, error) {
+	if len(input.Geometry) > 0 {
+		if err := checkGeoJSONValid(ctx, r.db, input.Geometry); err != nil {
+			return "", nil, err
+		}
+		return "ST_Multi(ST_SetSRID(ST_GeomFromGeoJSON(?), 4326))", []any{string(input.Geometry)}, nil
+	}
+
+	d, err := r.datasets.resolve(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var parts []string
+	var args []any
+	for _, code := range input.BoundaryCodes {
+		adminLevel := int32(strings.Count(code, "."))
+		query, ok := d.level(adminLevel)
+		if !ok {
+			return "", nil, fmt.Errorf("invalid boundary code: %s", code)
+		}
+		gid, err := resolveGID(ctx, r.db, d, code, adminLevel)
+		if err != nil {
+			return "", nil, err
+		}
+		whereClause, whereArgs := "gid_"+strconv.Itoa(int(adminLevel))+" = ?", []any{gid}
+
+		var count int64
+		if err := r.db.WithContext(ctx).Table(query.Table).Where(whereClause, whereArgs...).Count(&count).Error; err != nil {
+			return "", nil, err
+		}
+		if count == 0 {
+			return "", nil, fmt.Errorf("%w: %s", domain.ErrBoundaryNotFound, code)
+		}
+
+		parts = append(parts, fmt.Sprintf("SELECT geom FROM %s WHERE %s", query.Table, whereClause))
+		args = append(args, whereArgs...)
+	}
+
+	// ST_CollectionExtract keeps the polygons should the union degenerate into a collection
+	return "(SELECT ST_Multi(ST_CollectionExtract(ST_Union(geom), 3)) FROM (" + strings.Join(parts, " UNION ALL ") + ") boundaries)", args, nil
+}
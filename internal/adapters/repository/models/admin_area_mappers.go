@@ -35,3 +35,28 @@ func MapAdminSliceToDomain[T AdminArea](in []T) []*domain.AdminArea {
 	}
 	return out
 }
+
+func (m AdminAreaMetrics) ToDomain() *domain.AdminAreaMetrics {
+	return &domain.AdminAreaMetrics{
+		AreaKm2:        m.AreaKm2,
+		PerimeterKm:    m.PerimeterKm,
+		Centroid:       domain.Coordinate{Lat: m.CentroidLat, Lon: m.CentroidLon},
+		PointOnSurface: domain.Coordinate{Lat: m.SurfaceLat, Lon: m.SurfaceLon},
+		BBox:           domain.BBox{MinLon: m.MinLon, MinLat: m.MinLat, MaxLon: m.MaxLon, MaxLat: m.MaxLat},
+	}
+}
+
+func (m ClippedArea) ToDomain(adminLevel int32) *domain.ClippedArea {
+	return &domain.ClippedArea{
+		GID:        m.GID,
+		Name:       m.Name,
+		AdminLevel: adminLevel,
+		Geometry:   m.Geometry,
+		LengthKm:   m.LengthKm,
+		AreaKm2:    m.AreaKm2,
+	}
+}
+
+func (m CoordinateArea) ToDomain() *domain.CoordinateArea {
+	return &domain.CoordinateArea{Idx: m.Idx, GID: m.GID, Name: m.Name, AreaKm2: m.AreaKm2}
+}
@@ -50,3 +50,35 @@ type AdminArea4 struct {
 	Name     string `gorm:"column:name_4"`
 	Geometry []byte `gorm:"column:geom;type:geometry(MultiPolygon,4326)"`
 }
+
+// AdminAreaMetrics is the result row of the geometry metrics query
+type AdminAreaMetrics struct {
+	ID          int     `gorm:"column:id"`
+	AreaKm2     float64 `gorm:"column:area_km2"`
+	PerimeterKm float64 `gorm:"column:perimeter_km"`
+	CentroidLat float64 `gorm:"column:centroid_lat"`
+	CentroidLon float64 `gorm:"column:centroid_lon"`
+	SurfaceLat  float64 `gorm:"column:surface_lat"`
+	SurfaceLon  float64 `gorm:"column:surface_lon"`
+	MinLon      float64 `gorm:"column:min_lon"`
+	MinLat      float64 `gorm:"column:min_lat"`
+	MaxLon      float64 `gorm:"column:max_lon"`
+	MaxLat      float64 `gorm:"column:max_lat"`
+}
+
+// ClippedArea is the result row of clipping an input geometry by an admin level
+type ClippedArea struct {
+	GID      string   `gorm:"column:gid"`
+	Name     string   `gorm:"column:name"`
+	Geometry []byte   `gorm:"column:geom"`
+	LengthKm *float64 `gorm:"column:length_km"`
+	AreaKm2  *float64 `gorm:"column:area_km2"`
+}
+
+// CoordinateArea is the result row of locating an input coordinate in an admin level
+type CoordinateArea struct {
+	Idx     int     `gorm:"column:idx"`
+	GID     string  `gorm:"column:gid"`
+	Name    string  `gorm:"column:name"`
+	AreaKm2 float64 `gorm:"column:area_km2"`
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+)
+
+type Geofence struct {
+	ID            int       `gorm:"column:id"`
+	Name          string    `gorm:"column:name"`
+	Description   *string   `gorm:"column:description"`
+	BoundaryCodes []byte    `gorm:"column:boundary_codes"` // JSON array of GADM codes
+	Geometry      []byte    `gorm:"column:geom"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+	UpdatedAt     time.Time `gorm:"column:updated_at"`
+}
+
+// GeofenceMatch is a geofence containing the input coordinate at Idx
+type GeofenceMatch struct {
+	Idx      int `gorm:"column:idx"`
+	Geofence `gorm:"embedded"`
+}
+
+func (g Geofence) ToDomain() *domain.Geofence {
+	codes := []string{}
+	if len(g.BoundaryCodes) > 0 {
+		_ = json.Unmarshal(g.BoundaryCodes, &codes)
+	}
+	return &domain.Geofence{
+		ID:            g.ID,
+		Name:          g.Name,
+		Description:   g.Description,
+		BoundaryCodes: codes,
+		Geometry:      g.Geometry,
+		CreatedAt:     g.CreatedAt,
+		UpdatedAt:     g.UpdatedAt,
+	}
+}
@@ -23,6 +23,12 @@ type OSMLineAddressQuery struct {
 	Admin2   *string `gorm:"column:admin2"`
 	Admin1   *string `gorm:"column:admin1"`
 	Country  *string `gorm:"column:country"`
+	GID0     *string `gorm:"column:gid_0"`
+	GID1     *string `gorm:"column:gid_1"`
+	GID2     *string `gorm:"column:gid_2"`
+	GID3     *string `gorm:"column:gid_3"`
+	GID4     *string `gorm:"column:gid_4"`
+	OgcFID   *int32  `gorm:"column:ogc_fid"`
 }
 
 // GeoJSONPoint represents a GeoJSON point geometry
@@ -82,6 +88,12 @@ func (q OSMLineAddressQuery) ToDomain() *domain.LineWithAddress {
 			Admin2:  q.Admin2,
 			Admin3:  q.Admin3,
 			Admin4:  q.Admin4,
+			GID0:    q.GID0,
+			GID1:    q.GID1,
+			GID2:    q.GID2,
+			GID3:    q.GID3,
+			GID4:    q.GID4,
+			OgcFID:  q.OgcFID,
 		},
 	}
 }
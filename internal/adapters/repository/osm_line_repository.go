@@ -64,18 +64,24 @@ SELECT
     a.name_3 AS admin3,
     a.name_2 AS admin2,
     a.name_1 AS admin1,
-    a.country
+    a.country,
+    a.gid_0,
+    a.gid_1,
+    a.gid_2,
+    a.gid_3,
+    a.gid_4,
+    a.ogc_fid
 FROM road r
 LEFT JOIN LATERAL (
-    SELECT 4 AS lvl, name_4, name_3, name_2, name_1, country FROM admin4 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 4 AS lvl, ogc_fid, name_4, name_3, name_2, name_1, country, gid_0, gid_1, gid_2, gid_3, gid_4 FROM admin4 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 3, NULL, name_3, name_2, name_1, country FROM admin3 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 3, ogc_fid, NULL, name_3, name_2, name_1, country, gid_0, gid_1, gid_2, gid_3, NULL FROM admin3 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 2, NULL, NULL, name_2, name_1, country FROM admin2 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 2, ogc_fid, NULL, NULL, name_2, name_1, country, gid_0, gid_1, gid_2, NULL, NULL FROM admin2 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 1, NULL, NULL, NULL, name_1, country FROM admin1 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 1, ogc_fid, NULL, NULL, NULL, name_1, country, gid_0, gid_1, NULL, NULL, NULL FROM admin1 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 0, NULL, NULL, NULL, NULL, country FROM admin0 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 0, ogc_fid, NULL, NULL, NULL, NULL, country, gid_0, NULL, NULL, NULL, NULL FROM admin0 WHERE ST_Intersects(geom, r.geom_4326)
     ORDER BY lvl DESC
     LIMIT 1
 ) a ON TRUE;
@@ -103,18 +109,24 @@ SELECT
     a.name_3 AS admin3,
     a.name_2 AS admin2,
     a.name_1 AS admin1,
-    a.country
+    a.country,
+    a.gid_0,
+    a.gid_1,
+    a.gid_2,
+    a.gid_3,
+    a.gid_4,
+    a.ogc_fid
 FROM road r
 LEFT JOIN LATERAL (
-    SELECT 4 AS lvl, name_4, name_3, name_2, name_1, country FROM admin4 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 4 AS lvl, ogc_fid, name_4, name_3, name_2, name_1, country, gid_0, gid_1, gid_2, gid_3, gid_4 FROM admin4 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 3, NULL, name_3, name_2, name_1, country FROM admin3 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 3, ogc_fid, NULL, name_3, name_2, name_1, country, gid_0, gid_1, gid_2, gid_3, NULL FROM admin3 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 2, NULL, NULL, name_2, name_1, country FROM admin2 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 2, ogc_fid, NULL, NULL, name_2, name_1, country, gid_0, gid_1, gid_2, NULL, NULL FROM admin2 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 1, NULL, NULL, NULL, name_1, country FROM admin1 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 1, ogc_fid, NULL, NULL, NULL, name_1, country, gid_0, gid_1, NULL, NULL, NULL FROM admin1 WHERE ST_Intersects(geom, r.geom_4326)
     UNION ALL
-    SELECT 0, NULL, NULL, NULL, NULL, country FROM admin0 WHERE ST_Intersects(geom, r.geom_4326)
+    SELECT 0, ogc_fid, NULL, NULL, NULL, NULL, country, gid_0, NULL, NULL, NULL, NULL FROM admin0 WHERE ST_Intersects(geom, r.geom_4326)
     ORDER BY lvl DESC
     LIMIT 1
 ) a ON TRUE;
@@ -221,7 +233,8 @@ func getAddressByRoadName(db *gorm.DB, ctx context.Context, searchTerm string, l
 	var results []*domain.LineWithAddress
 	for rows.Next() {
 		var qr models.OSMLineAddressQuery
-		if err := rows.Scan(&qr.Name, &qr.NameEn, &qr.Geometry, &qr.Centroid, &qr.Admin4, &qr.Admin3, &qr.Admin2, &qr.Admin1, &qr.Country); err != nil {
+		if err := rows.Scan(&qr.Name, &qr.NameEn, &qr.Geometry, &qr.Centroid, &qr.Admin4, &qr.Admin3, &qr.Admin2, &qr.Admin1, &qr.Country,
+			&qr.GID0, &qr.GID1, &qr.GID2, &qr.GID3, &qr.GID4, &qr.OgcFID); err != nil {
 			return nil, err
 		}
 		results = append(results, qr.ToDomain())
@@ -1,5 +1,10 @@
 package domain
 
+import "errors"
+
+// ErrBoundaryNotFound is returned when no admin area of a level matches a code
+var ErrBoundaryNotFound = errors.New("boundary not found")
+
 type AdminArea struct {
 	ID         int     `json:"id"`
 	Name       string  `json:"name"`
@@ -7,12 +12,45 @@ type AdminArea struct {
 	AdminLevel int32   `json:"admin_level"`
 	ParentCode *string `json:"parent_code"`
 	Geometry   []byte  `json:"geom"`
+	Dataset    string  `json:"dataset"` // GADM dataset the area was read from, "" for the default tables
+}
+
+// Simplification selects how geometries are simplified for a given tolerance
+type Simplification string
+
+const (
+	// SimplificationStandard simplifies every polygon on its own
+	SimplificationStandard Simplification = "STANDARD"
+	// SimplificationCoverage simplifies a whole admin level as one coverage so shared borders stay seamless
+	SimplificationCoverage Simplification = "COVERAGE"
+)
+
+// ZoomTolerances is the tolerance ladder in degrees indexed by web-map zoom level.
+// Geometries are precomputed for these values, and coverage simplification only accepts them.
+var ZoomTolerances = []float64{0.5, 0.25, 0.1, 0.05, 0.025, 0.01, 0.005, 0.0025, 0.001, 0.0005, 0.00025, 0.0001}
+
+// ToleranceForZoom returns the preset tolerance for a zoom level, or nil when the
+// zoom is past the ladder and full-resolution geometry should be used
+func ToleranceForZoom(zoom int32) *float64 {
+	if zoom < 0 || int(zoom) >= len(ZoomTolerances) {
+		return nil
+	}
+	tolerance := ZoomTolerances[zoom]
+	return &tolerance
+}
+
+// GeometryOptions controls how an admin area's geometry is loaded
+type GeometryOptions struct {
+	Tolerance      *float64       // simplification tolerance in degrees, nil for full resolution
+	Simplification Simplification // empty means SimplificationStandard
+	Omit           bool           // skip serializing geometry when the client did not select it
 }
 
 type Coordinate struct {
-	ID  string
-	Lat float64
-	Lon float64
+	ID    string
+	Lat   float64
+	Lon   float64
+	Value *float64 // optional weight, summed per area when aggregating
 }
 
 type FilteredCoordinate struct {
@@ -20,3 +58,73 @@ type FilteredCoordinate struct {
 	Lat float64
 	Lon float64
 }
+
+// CoordinateArea places the input coordinate at Idx in an admin area
+type CoordinateArea struct {
+	Idx     int
+	GID     string
+	Name    string
+	AreaKm2 float64
+}
+
+// AreaAggregate summarizes the coordinates falling in one admin area
+type AreaAggregate struct {
+	GID        string
+	Name       string
+	AdminLevel int32
+	Count      int32
+	Sum        *float64 // total of the coordinate values, nil when none of them carried one
+	AreaKm2    float64
+	Density    float64 // coordinates per km2
+}
+
+// BBox is an axis-aligned bounding box in WGS84 degrees
+type BBox struct {
+	MinLon float64 `json:"min_lon"`
+	MinLat float64 `json:"min_lat"`
+	MaxLon float64 `json:"max_lon"`
+	MaxLat float64 `json:"max_lat"`
+}
+
+// AdminAreaMetrics holds measurements computed on the unsimplified geometry
+type AdminAreaMetrics struct {
+	AreaKm2        float64    `json:"area_km2"`
+	PerimeterKm    float64    `json:"perimeter_km"`
+	Centroid       Coordinate `json:"centroid"`
+	PointOnSurface Coordinate `json:"point_on_surface"`
+	BBox           BBox       `json:"bbox"`
+}
+
+// ClippedArea is the part of an input line or polygon falling inside one admin area.
+// LengthKm is set for line input and AreaKm2 for polygon input.
+type ClippedArea struct {
+	GID        string
+	Name       string
+	AdminLevel int32
+	Geometry   []byte // GeoJSON of the clipped part
+	LengthKm   *float64
+	AreaKm2    *float64
+}
+
+// AdminAreaNeighbor is an admin area bordering another one of the same level
+type AdminAreaNeighbor struct {
+	Area           *AdminArea `json:"area"`
+	SharedBorderKm float64    `json:"shared_border_km"` // 0 when the areas only meet at a point
+}
+
+// CellGrid identifies a discrete global grid that admin areas are indexed with
+type CellGrid string
+
+const (
+	// CellGridGeohash is the geohash grid; the resolution is the number of characters
+	CellGridGeohash CellGrid = "GEOHASH"
+	// CellGridH3 is Uber's hexagonal H3 grid; the resolution is from 0 to 15
+	CellGridH3 CellGrid = "H3"
+)
+
+// CellCoverage is an admin area overlapping a grid cell. The area's geometry is
+// limited to the bounding box of the cell, and Fraction is the share of the cell it covers.
+type CellCoverage struct {
+	Area     *AdminArea
+	Fraction float64
+}
@@ -0,0 +1,4 @@
+package domain
+
+// CacheKeyPrefixes start every key gapi caches: admin areas, grid cells, exports and road searches
+var CacheKeyPrefixes = []string{"admin_area", "cells", "export:", "osm_line"}
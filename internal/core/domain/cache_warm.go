@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// WarmOptions selects what the cache warmer loads: the areas of each level in each
+// geometry preset, and the children of every area of the level above.
+type WarmOptions struct {
+	Levels      []int32
+	Presets     []GeometryOptions
+	Concurrency int // requests in flight at once, at least 1
+}
+
+// WarmResult counts the requests a warm-up made and how many of them failed
+type WarmResult struct {
+	Requests int
+	Failed   int
+}
+
+// ParseWarmPresets parses a comma-separated list of the geometry variants to warm:
+// "full" for full resolution, "nogeom" for requests not selecting the geometry, or a
+// zoom level of the tolerance ladder, e.g. "full,nogeom,2,4"
+func ParseWarmPresets(s string) ([]GeometryOptions, error) {
+	var presets []GeometryOptions
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		switch part {
+		case "":
+			continue
+		case "full":
+			presets = append(presets, GeometryOptions{})
+		case "nogeom":
+			presets = append(presets, GeometryOptions{Omit: true})
+		default:
+			zoom, err := strconv.ParseInt(part, 10, 32)
+			if err != nil || zoom < 0 || int(zoom) >= len(ZoomTolerances) {
+				return nil, fmt.Errorf("invalid warm preset %q, must be full, nogeom or a zoom level between 0 and %d", part, len(ZoomTolerances)-1)
+			}
+			presets = append(presets, GeometryOptions{Tolerance: ToleranceForZoom(int32(zoom))})
+		}
+	}
+	if len(presets) == 0 {
+		return nil, fmt.Errorf("no warm presets in %q", s)
+	}
+	return presets, nil
+}
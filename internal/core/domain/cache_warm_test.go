@@ -0,0 +1,20 @@
+package domain
+
+import "testing"
+
+func TestParseWarmPresets(t *testing.T) {
+	presets, err := ParseWarmPresets("full, nogeom,2")
+	if err != nil {
+		t.Fatalf("ParseWarmPresets() error = %v", err)
+	}
+	if len(presets) != 3 || presets[0].Tolerance != nil || presets[0].Omit || !presets[1].Omit ||
+		presets[2].Tolerance == nil || *presets[2].Tolerance != ZoomTolerances[2] {
+		t.Errorf("ParseWarmPresets() = %+v", presets)
+	}
+
+	for _, invalid := range []string{"", "12", "-1", "coarse"} {
+		if _, err := ParseWarmPresets(invalid); err == nil {
+			t.Errorf("ParseWarmPresets(%q) error = nil, want an error", invalid)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "context"
+
+type datasetKey struct{}
+
+// WithDataset selects the GADM dataset admin areas are read from for the request.
+// An empty name selects the default dataset.
+func WithDataset(ctx context.Context, dataset string) context.Context {
+	return context.WithValue(ctx, datasetKey{}, dataset)
+}
+
+// DatasetFromContext returns the dataset selected with WithDataset, or "" for the default
+func DatasetFromContext(ctx context.Context) string {
+	dataset, _ := ctx.Value(datasetKey{}).(string)
+	return dataset
+}
+
+// CrosswalkMatch is an area of one dataset overlapping an area of another. The shares
+// are the fractions of the source and of the matched area covered by their overlap.
+type CrosswalkMatch struct {
+	Area        *AdminArea `json:"area"`
+	SourceShare float64    `json:"source_share"`
+	TargetShare float64    `json:"target_share"`
+}
+
+// DatasetKey suffixes a cache key prefix with the dataset selected for the request.
+// Keys of the default dataset are left unchanged.
+func DatasetKey(ctx context.Context, prefix string) string {
+	if dataset := DatasetFromContext(ctx); dataset != "" {
+		return prefix + "@" + dataset
+	}
+	return prefix
+}
@@ -0,0 +1,27 @@
+package domain
+
+// ExportScope selects the admin areas to export: a whole level, or the children
+// of a parent area when ParentCode is set
+type ExportScope struct {
+	AdminLevel int32
+	ParentCode *string
+	Geometry   GeometryOptions
+}
+
+// ExportFormat is a file format admin areas and roads can be exported to
+type ExportFormat string
+
+const (
+	// ExportFormatGeoJSON is a single GeoJSON FeatureCollection
+	ExportFormatGeoJSON ExportFormat = "geojson"
+	// ExportFormatGeoJSONSeq is newline-delimited GeoJSON features (NDJSON / GeoJSONSeq)
+	ExportFormatGeoJSONSeq ExportFormat = "geojsonseq"
+	// ExportFormatFlatGeobuf is a FlatGeobuf file with a packed Hilbert R-tree spatial index
+	ExportFormatFlatGeobuf ExportFormat = "flatgeobuf"
+	// ExportFormatGeoPackage is a GeoPackage (SQLite) file with a single feature table
+	ExportFormatGeoPackage ExportFormat = "gpkg"
+	// ExportFormatShapefile is a zipped ESRI Shapefile (.shp, .shx, .dbf, .prj and UTF-8 .cpg)
+	ExportFormatShapefile ExportFormat = "shapefile"
+	// ExportFormatKML is a KML document with attributes as ExtendedData
+	ExportFormatKML ExportFormat = "kml"
+)
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// Geofence is a custom named zone such as a delivery area. Its geometry is either
+// drawn by the client or built as the union of the GADM boundaries in BoundaryCodes.
+type Geofence struct {
+	ID            int
+	Name          string
+	Description   *string
+	BoundaryCodes []string // empty when the geometry was given directly
+	Geometry      []byte   // GeoJSON MultiPolygon
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// GeofenceDefinition describes a geofence to create or replace it; exactly one of Geometry and BoundaryCodes is set
+type GeofenceDefinition struct {
+	Name          string
+	Description   *string
+	Geometry      []byte   // GeoJSON Polygon or MultiPolygon
+	BoundaryCodes []string // GADM codes such as "THA.1_1", unioned into one geometry
+}
+
+// GeofenceMatch lists the geofences containing a coordinate
+type GeofenceMatch struct {
+	Coordinate *Coordinate
+	Geofences  []*Geofence
+}
+
+// GeofenceEventType tells whether a device entered or left a geofence
+type GeofenceEventType string
+
+const (
+	GeofenceEventEnter GeofenceEventType = "ENTER"
+	GeofenceEventExit  GeofenceEventType = "EXIT"
+)
+
+// GeofenceEvent is a device crossing a geofence boundary between two position reports
+type GeofenceEvent struct {
+	Type         GeofenceEventType `json:"type"`
+	DeviceID     string            `json:"device_id"`
+	GeofenceID   int               `json:"geofence_id"`
+	GeofenceName string            `json:"geofence_name"`
+	Lat          float64           `json:"lat"`
+	Lon          float64           `json:"lon"`
+	Timestamp    time.Time         `json:"timestamp"`
+}
+
+// GeofenceEventFilter selects events by device and geofence; an empty list matches all
+type GeofenceEventFilter struct {
+	DeviceIDs   []string
+	GeofenceIDs []int
+}
+
+// Matches reports whether the event passes both lists of the filter
+func (f GeofenceEventFilter) Matches(event *GeofenceEvent) bool {
+	if len(f.DeviceIDs) > 0 && !slices.Contains(f.DeviceIDs, event.DeviceID) {
+		return false
+	}
+	if len(f.GeofenceIDs) > 0 && !slices.Contains(f.GeofenceIDs, event.GeofenceID) {
+		return false
+	}
+	return true
+}
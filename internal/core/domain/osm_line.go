@@ -7,6 +7,12 @@ type AdminAddress struct {
 	Admin2  *string `json:"admin2"`  // district
 	Admin3  *string `json:"admin3"`  // subdistrict
 	Admin4  *string `json:"admin4"`  // ward
+	GID0    *string `json:"gid_0"`
+	GID1    *string `json:"gid_1"`
+	GID2    *string `json:"gid_2"`
+	GID3    *string `json:"gid_3"`
+	GID4    *string `json:"gid_4"`
+	OgcFID  *int32  `json:"ogc_fid"` // row ID of the deepest matched admin area
 }
 
 // OSMLine represents an OSM line feature from planet_osm_line table
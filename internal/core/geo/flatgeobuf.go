@@ -0,0 +1,383 @@
+package geo
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"sort"
+
+	flatbuffers "github.com/google/flatbuffers/go"
+)
+
+// flatGeobufMagic identifies a FlatGeobuf 3.x file
+var flatGeobufMagic = []byte{'f', 'g', 'b', 3, 'f', 'g', 'b', 0}
+
+// flatGeobufNodeSize is the branching factor of the packed Hilbert R-tree index
+const flatGeobufNodeSize = 16
+
+// FlatGeobuf column types, see ColumnType in the FlatGeobuf schema
+const (
+	fgbColumnLong   = 7
+	fgbColumnDouble = 10
+	fgbColumnString = 11
+)
+
+var fgbColumnTypes = map[ColumnType]byte{
+	ColumnString: fgbColumnString,
+	ColumnInt:    fgbColumnLong,
+	ColumnFloat:  fgbColumnDouble,
+}
+
+// fgbFeature is an encoded feature waiting for its place in the Hilbert order
+type fgbFeature struct {
+	bounds [4]float64
+	offset int64 // position in the spool file
+	size   int
+}
+
+// WriteFlatGeobuf encodes the layer as a FlatGeobuf file with a packed Hilbert R-tree
+// spatial index.
+func WriteFlatGeobuf(w io.Writer, layer Layer) error {
+	return StreamFlatGeobuf(w, layer, layer.Each)
+}
+
+// StreamFlatGeobuf encodes the records of source as WriteFlatGeobuf does, taking the
+// name, geometry type and columns from layer. Features are reordered along the Hilbert
+// curve of their bounding box centres, so they are encoded to a temporary file first
+// and only their bounding boxes and positions are kept in memory.
+func StreamFlatGeobuf(w io.Writer, layer Layer, source RecordSource) error {
+	spool, err := os.CreateTemp("", "gapi-*.fgb")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		spool.Close()
+		os.Remove(spool.Name())
+	}()
+
+	spooled := bufio.NewWriter(spool)
+	var features []fgbFeature
+	var offset int64
+	extent := newExtent()
+	err = source(func(r Record) error {
+		data := encodeFGBFeature(r)
+		minX, minY, maxX, maxY := r.Geometry.Bounds()
+		features = append(features, fgbFeature{bounds: [4]float64{minX, minY, maxX, maxY}, offset: offset, size: len(data)})
+		offset += int64(len(data))
+		extent.add(r.Geometry)
+		_, err := spooled.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if err := spooled.Flush(); err != nil {
+		return err
+	}
+
+	sortHilbert(features, extent)
+
+	if _, err := w.Write(flatGeobufMagic); err != nil {
+		return err
+	}
+	if _, err := w.Write(encodeFGBHeader(layer, extent, len(features))); err != nil {
+		return err
+	}
+	if len(features) > 0 {
+		if _, err := w.Write(packedRTree(features)); err != nil {
+			return err
+		}
+	}
+	var buf []byte
+	for _, f := range features {
+		buf = slices.Grow(buf[:0], f.size)[:f.size]
+		if _, err := spool.ReadAt(buf, f.offset); err != nil {
+			return err
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func encodeFGBHeader(layer Layer, extent extent, count int) []byte {
+	nodeSize := uint16(flatGeobufNodeSize)
+	if count == 0 {
+		nodeSize = 0
+	}
+
+	b := flatbuffers.NewBuilder(256)
+	name := b.CreateString(layer.Name)
+	var envelope flatbuffers.UOffsetT
+	if count > 0 {
+		envelope = fgbDoubles(b, extent[:])
+	}
+	columns := make([]flatbuffers.UOffsetT, len(layer.Columns))
+	for i, column := range layer.Columns {
+		columnName := b.CreateString(column.Name)
+		b.StartObject(2)
+		b.PrependUOffsetTSlot(0, columnName, 0)
+		b.PrependByteSlot(1, fgbColumnTypes[column.Type], 0)
+		columns[i] = b.EndObject()
+	}
+	columnVector := fgbOffsets(b, columns)
+	org := b.CreateString("EPSG")
+	b.StartObject(2)
+	b.PrependUOffsetTSlot(0, org, 0)
+	b.PrependInt32Slot(1, 4326, 0)
+	crs := b.EndObject()
+
+	// Fields of the Header table, by id
+	b.StartObject(11)
+	b.PrependUOffsetTSlot(0, name, 0)
+	b.PrependUOffsetTSlot(1, envelope, 0)
+	b.PrependByteSlot(2, byte(layer.GeometryType), 0)
+	b.PrependUOffsetTSlot(7, columnVector, 0)
+	b.PrependUint64Slot(8, uint64(count), 0)
+	b.PrependUint16Slot(9, nodeSize, flatGeobufNodeSize)
+	b.PrependUOffsetTSlot(10, crs, 0)
+	b.FinishSizePrefixed(b.EndObject())
+	return b.FinishedBytes()
+}
+
+func encodeFGBFeature(r Record) []byte {
+	b := flatbuffers.NewBuilder(256)
+	geometry := encodeFGBGeometry(b, r.Geometry)
+	properties := b.CreateByteVector(encodeFGBProperties(r.Values))
+
+	b.StartObject(2)
+	b.PrependUOffsetTSlot(0, geometry, 0)
+	b.PrependUOffsetTSlot(1, properties, 0)
+	b.FinishSizePrefixed(b.EndObject())
+	return b.FinishedBytes()
+}
+
+// encodeFGBGeometry writes a Geometry table: flat xy coordinates with ring or line
+// ends, and one part per polygon for MultiPolygons
+func encodeFGBGeometry(b *flatbuffers.Builder, g Geometry) flatbuffers.UOffsetT {
+	if g.Type == GeometryMultiPolygon {
+		parts := make([]flatbuffers.UOffsetT, len(g.Polygons))
+		for i := range g.Polygons {
+			parts[i] = encodeFGBGeometry(b, Geometry{Type: GeometryPolygon, Polygons: g.Polygons[i : i+1]})
+		}
+		partVector := fgbOffsets(b, parts)
+		b.StartObject(8)
+		b.PrependByteSlot(6, byte(GeometryMultiPolygon), 0)
+		b.PrependUOffsetTSlot(7, partVector, 0)
+		return b.EndObject()
+	}
+
+	var points []Point
+	var ends []uint32
+	switch g.Type {
+	case GeometryPoint, GeometryMultiPoint:
+		points = g.Points
+	case GeometryLineString, GeometryMultiLineString:
+		for _, line := range g.Lines {
+			points = append(points, line...)
+			ends = append(ends, uint32(len(points)))
+		}
+	case GeometryPolygon:
+		if len(g.Polygons) > 0 {
+			for _, ring := range g.Polygons[0] {
+				points = append(points, ring...)
+				ends = append(ends, uint32(len(points)))
+			}
+		}
+	}
+
+	// A single line or ring needs no ends
+	var endVector flatbuffers.UOffsetT
+	if len(ends) > 1 {
+		b.StartVector(4, len(ends), 4)
+		for i := len(ends) - 1; i >= 0; i-- {
+			b.PrependUint32(ends[i])
+		}
+		endVector = b.EndVector(len(ends))
+	}
+	xy := make([]float64, 0, 2*len(points))
+	for _, p := range points {
+		xy = append(xy, p[0], p[1])
+	}
+	xyVector := fgbDoubles(b, xy)
+
+	b.StartObject(7)
+	b.PrependUOffsetTSlot(0, endVector, 0)
+	b.PrependUOffsetTSlot(1, xyVector, 0)
+	b.PrependByteSlot(6, byte(g.Type), 0)
+	return b.EndObject()
+}
+
+// encodeFGBProperties writes each set value as its column index followed by the value
+func encodeFGBProperties(values []any) []byte {
+	buf := []byte{}
+	for i, value := range values {
+		switch v := value.(type) {
+		case string:
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(i))
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(len(v)))
+			buf = append(buf, v...)
+		case int64:
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(i))
+			buf = binary.LittleEndian.AppendUint64(buf, uint64(v))
+		case float64:
+			buf = binary.LittleEndian.AppendUint16(buf, uint16(i))
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+		}
+	}
+	return buf
+}
+
+func fgbDoubles(b *flatbuffers.Builder, values []float64) flatbuffers.UOffsetT {
+	b.StartVector(8, len(values), 8)
+	for i := len(values) - 1; i >= 0; i-- {
+		b.PrependFloat64(values[i])
+	}
+	return b.EndVector(len(values))
+}
+
+// fgbOffsets writes a vector of tables written before it
+func fgbOffsets(b *flatbuffers.Builder, tables []flatbuffers.UOffsetT) flatbuffers.UOffsetT {
+	b.StartVector(4, len(tables), 4)
+	for i := len(tables) - 1; i >= 0; i-- {
+		b.PrependUOffsetT(tables[i])
+	}
+	return b.EndVector(len(tables))
+}
+
+// sortHilbert orders features along the Hilbert curve of their bounding box centres within extent
+func sortHilbert(features []fgbFeature, extent extent) {
+	const hilbertMax = (1 << 16) - 1
+	width, height := extent[2]-extent[0], extent[3]-extent[1]
+
+	values := make([]uint32, len(features))
+	for i, f := range features {
+		var x, y uint32
+		if width > 0 {
+			x = uint32(math.Floor(hilbertMax * ((f.bounds[0]+f.bounds[2])/2 - extent[0]) / width))
+		}
+		if height > 0 {
+			y = uint32(math.Floor(hilbertMax * ((f.bounds[1]+f.bounds[3])/2 - extent[1]) / height))
+		}
+		values[i] = hilbert(x, y)
+	}
+
+	indices := make([]int, len(features))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(a, b int) bool { return values[indices[a]] > values[indices[b]] })
+
+	sorted := make([]fgbFeature, len(features))
+	for i, j := range indices {
+		sorted[i] = features[j]
+	}
+	copy(features, sorted)
+}
+
+// packedRTree builds the index over the sorted features: levels are stored from the
+// root down to the leaves, leaves point at byte offsets of features in the data
+// section and parents at the position of their first child.
+func packedRTree(features []fgbFeature) []byte {
+	type node struct {
+		bounds [4]float64
+		offset uint64
+	}
+
+	levelSizes := []int{len(features)}
+	total := len(features)
+	for n := len(features); ; {
+		n = (n + flatGeobufNodeSize - 1) / flatGeobufNodeSize
+		levelSizes = append(levelSizes, n)
+		total += n
+		if n == 1 {
+			break
+		}
+	}
+	// levelStarts[0] is the leaf level at the end of the array, the root is at 0
+	levelStarts := make([]int, len(levelSizes))
+	for i, end := 0, total; i < len(levelSizes); i++ {
+		levelStarts[i] = end - levelSizes[i]
+		end = levelStarts[i]
+	}
+
+	nodes := make([]node, total)
+	var offset uint64
+	for i, f := range features {
+		nodes[levelStarts[0]+i] = node{bounds: f.bounds, offset: offset}
+		offset += uint64(f.size)
+	}
+	for level := 0; level < len(levelSizes)-1; level++ {
+		parent := levelStarts[level+1]
+		for pos, end := levelStarts[level], levelStarts[level]+levelSizes[level]; pos < end; parent++ {
+			n := node{bounds: [4]float64{math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)}, offset: uint64(pos)}
+			for j := 0; j < flatGeobufNodeSize && pos < end; j, pos = j+1, pos+1 {
+				child := nodes[pos].bounds
+				n.bounds = [4]float64{
+					min(n.bounds[0], child[0]), min(n.bounds[1], child[1]),
+					max(n.bounds[2], child[2]), max(n.bounds[3], child[3]),
+				}
+			}
+			nodes[parent] = n
+		}
+	}
+
+	buf := make([]byte, 0, total*40)
+	for _, n := range nodes {
+		for _, v := range n.bounds {
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+		}
+		buf = binary.LittleEndian.AppendUint64(buf, n.offset)
+	}
+	return buf
+}
+
+// hilbert maps a position on a 2^16 x 2^16 grid to its distance along the Hilbert curve
+func hilbert(x, y uint32) uint32 {
+	a := x ^ y
+	b := 0xFFFF ^ a
+	c := 0xFFFF ^ (x | y)
+	d := x & (y ^ 0xFFFF)
+
+	A := a | (b >> 1)
+	B := (a >> 1) ^ a
+	C := ((c >> 1) ^ (b & (d >> 1))) ^ c
+	D := ((a & (c >> 1)) ^ (d >> 1)) ^ d
+
+	a, b, c, d = A, B, C, D
+	A = (a & (a >> 2)) ^ (b & (b >> 2))
+	B = (a & (b >> 2)) ^ (b & ((a ^ b) >> 2))
+	C ^= (a & (c >> 2)) ^ (b & (d >> 2))
+	D ^= (b & (c >> 2)) ^ ((a ^ b) & (d >> 2))
+
+	a, b, c, d = A, B, C, D
+	A = (a & (a >> 4)) ^ (b & (b >> 4))
+	B = (a & (b >> 4)) ^ (b & ((a ^ b) >> 4))
+	C ^= (a & (c >> 4)) ^ (b & (d >> 4))
+	D ^= (b & (c >> 4)) ^ ((a ^ b) & (d >> 4))
+
+	a, b, c, d = A, B, C, D
+	C ^= (a & (c >> 8)) ^ (b & (d >> 8))
+	D ^= (b & (c >> 8)) ^ ((a ^ b) & (d >> 8))
+
+	a = C ^ (C >> 1)
+	b = D ^ (D >> 1)
+
+	i0 := x ^ y
+	i1 := b | (0xFFFF ^ (i0 | a))
+	i0 = interleave(i0)
+	i1 = interleave(i1)
+	return (i1 << 1) | i0
+}
+
+// interleave spreads the low 16 bits of v to the even bit positions
+func interleave(v uint32) uint32 {
+	v = (v | (v << 8)) & 0x00FF00FF
+	v = (v | (v << 4)) & 0x0F0F0F0F
+	v = (v | (v << 2)) & 0x33333333
+	return (v | (v << 1)) & 0x55555555
+}
@@ -0,0 +1,375 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+// fbTable reads fields of a FlatBuffers table through its vtable
+type fbTable struct {
+	buf []byte
+	pos int
+}
+
+func sizePrefixedRoot(buf []byte) fbTable {
+	return fbTable{buf, 4 + int(binary.LittleEndian.Uint32(buf[4:]))}
+}
+
+func (t fbTable) field(id int) int {
+	vtable := t.pos - int(int32(binary.LittleEndian.Uint32(t.buf[t.pos:])))
+	if 4+2*id >= int(binary.LittleEndian.Uint16(t.buf[vtable:])) {
+		return 0
+	}
+	offset := int(binary.LittleEndian.Uint16(t.buf[vtable+4+2*id:]))
+	if offset == 0 {
+		return 0
+	}
+	return t.pos + offset
+}
+
+func (t fbTable) deref(id int) int {
+	pos := t.field(id)
+	return pos + int(binary.LittleEndian.Uint32(t.buf[pos:]))
+}
+
+func (t fbTable) string(id int) string {
+	pos := t.deref(id)
+	return string(t.buf[pos+4 : pos+4+int(binary.LittleEndian.Uint32(t.buf[pos:]))])
+}
+
+func (t fbTable) vector(id int) (start, n int) {
+	if t.field(id) == 0 {
+		return 0, 0
+	}
+	pos := t.deref(id)
+	return pos + 4, int(binary.LittleEndian.Uint32(t.buf[pos:]))
+}
+
+func (t fbTable) doubles(id int) []float64 {
+	start, n := t.vector(id)
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = math.Float64frombits(binary.LittleEndian.Uint64(t.buf[start+8*i:]))
+	}
+	return out
+}
+
+func (t fbTable) tables(id int) []fbTable {
+	start, n := t.vector(id)
+	out := make([]fbTable, n)
+	for i := range out {
+		pos := start + 4*i
+		out[i] = fbTable{t.buf, pos + int(binary.LittleEndian.Uint32(t.buf[pos:]))}
+	}
+	return out
+}
+
+func (t fbTable) table(id int) fbTable {
+	return fbTable{t.buf, t.deref(id)}
+}
+
+func testLayer(n int) Layer {
+	layer := Layer{
+		Name:         "admin1",
+		GeometryType: GeometryMultiPolygon,
+		Columns:      []Column{{"name", ColumnString}, {"population", ColumnInt}, {"density", ColumnFloat}},
+	}
+	for i := 0; i < n; i++ {
+		x, y := float64(i%10), float64(i/10)
+		layer.Records = append(layer.Records, Record{
+			Geometry: Geometry{Type: GeometryMultiPolygon, Polygons: square(x, y, x+0.5, y+0.5)},
+			Values:   []any{"area", int64(i), nil},
+		})
+	}
+	return layer
+}
+
+func TestWriteFlatGeobufHeader(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFlatGeobuf(&buf, testLayer(3)); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if !bytes.Equal(data[:8], flatGeobufMagic) {
+		t.Fatalf("unexpected magic bytes %v", data[:8])
+	}
+	header := sizePrefixedRoot(data[8:])
+
+	if name := header.string(0); name != "admin1" {
+		t.Errorf("expected name admin1, got %q", name)
+	}
+	if envelope := header.doubles(1); len(envelope) != 4 || envelope[0] != 0 || envelope[3] != 0.5 || envelope[2] != 2.5 {
+		t.Errorf("unexpected envelope %v", envelope)
+	}
+	if start, _ := header.vector(1); start%8 != 0 {
+		t.Errorf("envelope is not 8-byte aligned at %d", start)
+	}
+	if geometryType := header.buf[header.field(2)]; GeometryType(geometryType) != GeometryMultiPolygon {
+		t.Errorf("expected MultiPolygon geometry type, got %d", geometryType)
+	}
+	columns := header.tables(7)
+	if len(columns) != 3 || columns[1].string(0) != "population" || columns[1].buf[columns[1].field(1)] != fgbColumnLong {
+		t.Errorf("unexpected columns")
+	}
+	if count := binary.LittleEndian.Uint64(data[8+header.field(8):]); count != 3 {
+		t.Errorf("expected 3 features, got %d", count)
+	}
+	// The node size is left out when it is the schema default of 16
+	if header.field(9) != 0 {
+		t.Errorf("expected the default node size, got %d", binary.LittleEndian.Uint16(data[8+header.field(9):]))
+	}
+	if code := binary.LittleEndian.Uint32(data[8+header.table(10).field(1):]); code != 4326 {
+		t.Errorf("expected EPSG:4326, got %d", code)
+	}
+}
+
+func TestWriteFlatGeobufIndex(t *testing.T) {
+	const count = 40
+	var buf bytes.Buffer
+	if err := WriteFlatGeobuf(&buf, testLayer(count)); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	headerSize := int(binary.LittleEndian.Uint32(data[8:]))
+	index := data[12+headerSize:]
+	// 40 leaves, 3 parents and the root
+	const nodes = count + 3 + 1
+	features := index[nodes*40:]
+
+	node := func(i int) (bounds [4]float64, offset uint64) {
+		for j := range bounds {
+			bounds[j] = math.Float64frombits(binary.LittleEndian.Uint64(index[i*40+8*j:]))
+		}
+		return bounds, binary.LittleEndian.Uint64(index[i*40+32:])
+	}
+
+	if root, first := node(0); root != [4]float64{0, 0, 9.5, 3.5} || first != 1 {
+		t.Errorf("unexpected root %v pointing at %d", root, first)
+	}
+
+	seen := map[int64]bool{}
+	for i := nodes - count; i < nodes; i++ {
+		bounds, offset := node(i)
+		feature := sizePrefixedRoot(features[offset:])
+
+		xy := feature.table(0).tables(7)[0].doubles(1)
+		minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+		for k := 0; k < len(xy); k += 2 {
+			minX, maxX = min(minX, xy[k]), max(maxX, xy[k])
+			minY, maxY = min(minY, xy[k+1]), max(maxY, xy[k+1])
+		}
+		if bounds != [4]float64{minX, minY, maxX, maxY} {
+			t.Errorf("leaf %d bounds %v do not match its feature", i, bounds)
+		}
+
+		start, n := feature.vector(1)
+		properties := feature.buf[start : start+n]
+		if binary.LittleEndian.Uint16(properties) != 0 || binary.LittleEndian.Uint16(properties[10:]) != 1 {
+			t.Fatalf("unexpected property layout %v", properties)
+		}
+		seen[int64(binary.LittleEndian.Uint64(properties[12:]))] = true
+	}
+	if len(seen) != count {
+		t.Errorf("expected every feature to be indexed once, got %d", len(seen))
+	}
+}
+
+func TestWriteFlatGeobufEmptyLayer(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFlatGeobuf(&buf, Layer{Name: "roads", GeometryType: GeometryMultiLineString}); err != nil {
+		t.Fatal(err)
+	}
+	header := sizePrefixedRoot(buf.Bytes()[8:])
+
+	if nodeSize := binary.LittleEndian.Uint16(header.buf[header.field(9):]); nodeSize != 0 {
+		t.Errorf("expected no index for an empty layer, got node size %d", nodeSize)
+	}
+	if len(buf.Bytes()) != 12+int(binary.LittleEndian.Uint32(buf.Bytes()[8:])) {
+		t.Errorf("expected nothing after the header")
+	}
+}
+
+func (t fbTable) uint32s(id int) []uint32 {
+	start, n := t.vector(id)
+	out := make([]uint32, n)
+	for i := range out {
+		out[i] = binary.LittleEndian.Uint32(t.buf[start+4*i:])
+	}
+	return out
+}
+
+// readFGBGeometry decodes xy coordinates split at their ends into point sequences
+func readFGBGeometry(g fbTable) [][]Point {
+	xy := g.doubles(1)
+	ends := g.uint32s(0)
+	if len(ends) == 0 {
+		ends = []uint32{uint32(len(xy) / 2)}
+	}
+	var parts [][]Point
+	start := 0
+	for _, end := range ends {
+		part := make([]Point, 0, int(end)-start)
+		for i := start; i < int(end); i++ {
+			part = append(part, Point{xy[2*i], xy[2*i+1]})
+		}
+		parts = append(parts, part)
+		start = int(end)
+	}
+	return parts
+}
+
+// readFlatGeobuf parses a file back into a layer, taking features in index order
+func readFlatGeobuf(t *testing.T, data []byte) Layer {
+	t.Helper()
+	if !bytes.Equal(data[:8], flatGeobufMagic) {
+		t.Fatalf("unexpected magic bytes %v", data[:8])
+	}
+	header := sizePrefixedRoot(data[8:])
+	layer := Layer{Name: header.string(0), GeometryType: GeometryType(header.buf[header.field(2)])}
+	for _, column := range header.tables(7) {
+		var columnType ColumnType
+		switch column.buf[column.field(1)] {
+		case fgbColumnLong:
+			columnType = ColumnInt
+		case fgbColumnDouble:
+			columnType = ColumnFloat
+		}
+		layer.Columns = append(layer.Columns, Column{Name: column.string(0), Type: columnType})
+	}
+	count := int(binary.LittleEndian.Uint64(header.buf[header.field(8):]))
+
+	offset := 12 + int(binary.LittleEndian.Uint32(data[8:]))
+	nodes := 0
+	for n := count; count > 0; {
+		nodes += n
+		if n == 1 {
+			break
+		}
+		n = (n + flatGeobufNodeSize - 1) / flatGeobufNodeSize
+	}
+	index := data[offset : offset+40*nodes]
+	features := data[offset+40*nodes:]
+
+	end := 0
+	for i := nodes - count; i < nodes; i++ {
+		featureOffset := int(binary.LittleEndian.Uint64(index[i*40+32:]))
+		feature := sizePrefixedRoot(features[featureOffset:])
+		end = max(end, featureOffset+4+int(binary.LittleEndian.Uint32(features[featureOffset:])))
+
+		geometry := Geometry{Type: layer.GeometryType}
+		g := feature.table(0)
+		switch layer.GeometryType {
+		case GeometryMultiPolygon:
+			for _, part := range g.tables(7) {
+				var polygon Polygon
+				for _, ring := range readFGBGeometry(part) {
+					polygon = append(polygon, ring)
+				}
+				geometry.Polygons = append(geometry.Polygons, polygon)
+			}
+		case GeometryMultiLineString:
+			for _, line := range readFGBGeometry(g) {
+				geometry.Lines = append(geometry.Lines, line)
+			}
+		}
+
+		values := make([]any, len(layer.Columns))
+		start, n := feature.vector(1)
+		for properties := feature.buf[start : start+n]; len(properties) > 0; {
+			column := binary.LittleEndian.Uint16(properties)
+			properties = properties[2:]
+			switch layer.Columns[column].Type {
+			case ColumnString:
+				size := binary.LittleEndian.Uint32(properties)
+				values[column] = string(properties[4 : 4+size])
+				properties = properties[4+size:]
+			case ColumnInt:
+				values[column] = int64(binary.LittleEndian.Uint64(properties))
+				properties = properties[8:]
+			case ColumnFloat:
+				values[column] = math.Float64frombits(binary.LittleEndian.Uint64(properties))
+				properties = properties[8:]
+			}
+		}
+		layer.Records = append(layer.Records, Record{Geometry: geometry, Values: values})
+	}
+	if end != len(features) {
+		t.Errorf("features end at %d, file at %d", end, len(features))
+	}
+	return layer
+}
+
+func TestWriteFlatGeobufRoundTrip(t *testing.T) {
+	layer := testLayer(300)
+	for i := range layer.Records {
+		layer.Records[i].Values[0] = fmt.Sprintf("พื้นที่ %d", i)
+		if i%2 == 0 {
+			layer.Records[i].Values[2] = float64(i) / 3
+		}
+		if i%5 == 0 {
+			// A second part with a hole
+			layer.Records[i].Geometry.Polygons = append(layer.Records[i].Geometry.Polygons, Polygon{
+				{{20, 20}, {24, 20}, {24, 24}, {20, 24}, {20, 20}},
+				{{21, 21}, {21, 22}, {22, 22}, {22, 21}, {21, 21}},
+			})
+		}
+	}
+	var buf bytes.Buffer
+	if err := WriteFlatGeobuf(&buf, layer); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readFlatGeobuf(t, buf.Bytes())
+	if got.Name != layer.Name || got.GeometryType != layer.GeometryType || !reflect.DeepEqual(got.Columns, layer.Columns) {
+		t.Fatalf("header %s %v %v does not match the layer", got.Name, got.GeometryType, got.Columns)
+	}
+	// Features are stored in Hilbert order, so compare them by population
+	byPopulation := map[int64]Record{}
+	for _, r := range got.Records {
+		byPopulation[r.Values[1].(int64)] = r
+	}
+	if len(byPopulation) != len(layer.Records) {
+		t.Fatalf("read %d distinct features, want %d", len(byPopulation), len(layer.Records))
+	}
+	for _, want := range layer.Records {
+		if r := byPopulation[want.Values[1].(int64)]; !reflect.DeepEqual(r, want) {
+			t.Errorf("feature %v does not round-trip: %v", want.Values, r)
+		}
+	}
+}
+
+func TestWriteFlatGeobufLinesRoundTrip(t *testing.T) {
+	layer := Layer{
+		Name:         "roads",
+		GeometryType: GeometryMultiLineString,
+		Columns:      []Column{{"name", ColumnString}, {"name_en", ColumnString}},
+		Records: []Record{
+			{Geometry: Geometry{Type: GeometryMultiLineString, Lines: []LineString{{{100, 13}, {100.5, 13.5}}}}, Values: []any{"สุขุมวิท", "Sukhumvit"}},
+			{Geometry: Geometry{Type: GeometryMultiLineString, Lines: []LineString{{{101, 14}, {101, 15}, {102, 15}}, {{103, 16}, {104, 17}}}}, Values: []any{"พระราม 4", nil}},
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteFlatGeobuf(&buf, layer); err != nil {
+		t.Fatal(err)
+	}
+
+	got := readFlatGeobuf(t, buf.Bytes())
+	if len(got.Records) != 2 {
+		t.Fatalf("read %d features, want 2", len(got.Records))
+	}
+	for _, r := range got.Records {
+		want := layer.Records[0]
+		if r.Values[0] != want.Values[0] {
+			want = layer.Records[1]
+		}
+		if !reflect.DeepEqual(r, want) {
+			t.Errorf("feature %v does not round-trip: %v", want.Values, r)
+		}
+	}
+}
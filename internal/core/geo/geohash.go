@@ -0,0 +1,145 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// geohashAlphabet is the base32 alphabet of geohashes, without a, i, l and o
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeohashPrecision is the longest geohash supported; 12 characters is a few centimetres
+const MaxGeohashPrecision = 12
+
+// Rect is an axis-aligned rectangle in WGS84 degrees
+type Rect struct {
+	MinX, MinY, MaxX, MaxY float64
+}
+
+// Area returns the planar area of the rectangle in square degrees
+func (r Rect) Area() float64 {
+	return (r.MaxX - r.MinX) * (r.MaxY - r.MinY)
+}
+
+// geohashCellSize returns the width and height in degrees of the cells at a precision.
+// Bits alternate starting with longitude, so longitude gets the extra bit of odd counts.
+func geohashCellSize(precision int) (width, height float64) {
+	bits := 5 * precision
+	lonBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 360 / math.Exp2(float64(lonBits)), 180 / math.Exp2(float64(latBits))
+}
+
+// EncodeGeohash returns the geohash of the cell containing the position
+func EncodeGeohash(lat, lon float64, precision int) string {
+	minLat, maxLat := -90.0, 90.0
+	minLon, maxLon := -180.0, 180.0
+
+	var sb strings.Builder
+	sb.Grow(precision)
+	even := true
+	bit, ch := 0, 0
+	for sb.Len() < precision {
+		if even {
+			mid := (minLon + maxLon) / 2
+			if lon >= mid {
+				ch |= 1 << (4 - bit)
+				minLon = mid
+			} else {
+				maxLon = mid
+			}
+		} else {
+			mid := (minLat + maxLat) / 2
+			if lat >= mid {
+				ch |= 1 << (4 - bit)
+				minLat = mid
+			} else {
+				maxLat = mid
+			}
+		}
+		even = !even
+		if bit < 4 {
+			bit++
+			continue
+		}
+		sb.WriteByte(geohashAlphabet[ch])
+		bit, ch = 0, 0
+	}
+	return sb.String()
+}
+
+// DecodeGeohash returns the cell of a geohash
+func DecodeGeohash(hash string) (Rect, error) {
+	if len(hash) == 0 || len(hash) > MaxGeohashPrecision {
+		return Rect{}, fmt.Errorf("geohash must have 1 to %d characters", MaxGeohashPrecision)
+	}
+
+	cell := Rect{MinX: -180, MinY: -90, MaxX: 180, MaxY: 90}
+	even := true
+	for i := 0; i < len(hash); i++ {
+		value := strings.IndexByte(geohashAlphabet, hash[i])
+		if value < 0 {
+			return Rect{}, fmt.Errorf("invalid geohash character %q", hash[i])
+		}
+		for bit := 4; bit >= 0; bit-- {
+			set := value&(1<<bit) != 0
+			if even {
+				mid := (cell.MinX + cell.MaxX) / 2
+				if set {
+					cell.MinX = mid
+				} else {
+					cell.MaxX = mid
+				}
+			} else {
+				mid := (cell.MinY + cell.MaxY) / 2
+				if set {
+					cell.MinY = mid
+				} else {
+					cell.MaxY = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return cell, nil
+}
+
+// GeohashPolyfill returns the geohashes whose cell centre lies inside the multipolygon,
+// sorted. Rows of cells are filled between the crossings of their centre line with the
+// rings, so the cost grows with rows times vertices rather than cells times vertices.
+// It fails when the bounding box holds more than maxCells cells.
+func GeohashPolyfill(mp MultiPolygon, precision int, maxCells int) ([]string, error) {
+	if precision < 1 || precision > MaxGeohashPrecision {
+		return nil, fmt.Errorf("geohash precision must be between 1 and %d", MaxGeohashPrecision)
+	}
+	if len(mp) == 0 {
+		return []string{}, nil
+	}
+
+	width, height := geohashCellSize(precision)
+	minX, minY, maxX, maxY := mp.Bounds()
+	// Bounds on the antimeridian or a pole fall past the last column or row
+	firstCol, lastCol := math.Floor((minX+180)/width), math.Min(math.Floor((maxX+180)/width), 360/width-1)
+	firstRow, lastRow := math.Floor((minY+90)/height), math.Min(math.Floor((maxY+90)/height), 180/height-1)
+	if (lastCol-firstCol+1)*(lastRow-firstRow+1) > float64(maxCells) {
+		return nil, fmt.Errorf("area spans more than %d cells at precision %d", maxCells, precision)
+	}
+
+	cells := []string{}
+	for row := firstRow; row <= lastRow; row++ {
+		y := -90 + (row+0.5)*height
+		crossings := mp.Crossings(y)
+		// Even-odd fill: centres between the first and second crossing are inside, and so on
+		for i := 0; i+1 < len(crossings); i += 2 {
+			start := math.Max(firstCol, math.Ceil((crossings[i]+180)/width-0.5))
+			end := math.Min(lastCol, math.Floor((crossings[i+1]+180)/width-0.5))
+			for col := start; col <= end; col++ {
+				cells = append(cells, EncodeGeohash(y, -180+(col+0.5)*width, precision))
+			}
+		}
+	}
+	sort.Strings(cells)
+	return cells, nil
+}
@@ -0,0 +1,106 @@
+package geo
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	tests := []struct {
+		lat, lon  float64
+		precision int
+		want      string
+	}{
+		{42.6, -5.6, 5, "ezs42"},
+		{57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{-90, -180, 3, "000"},
+	}
+	for _, tt := range tests {
+		if got := EncodeGeohash(tt.lat, tt.lon, tt.precision); got != tt.want {
+			t.Errorf("EncodeGeohash(%v, %v, %d) = %s, want %s", tt.lat, tt.lon, tt.precision, got, tt.want)
+		}
+	}
+}
+
+func TestDecodeGeohash(t *testing.T) {
+	cell, err := DecodeGeohash("ezs42")
+	if err != nil {
+		t.Fatalf("DecodeGeohash() error = %v", err)
+	}
+	if cell.MinY > 42.6 || cell.MaxY < 42.6 || cell.MinX > -5.6 || cell.MaxX < -5.6 {
+		t.Errorf("cell %+v does not contain the encoded position", cell)
+	}
+	width, height := geohashCellSize(5)
+	if math.Abs(cell.MaxX-cell.MinX-width) > 1e-12 || math.Abs(cell.MaxY-cell.MinY-height) > 1e-12 {
+		t.Errorf("cell %+v is not %v x %v degrees", cell, width, height)
+	}
+
+	for _, hash := range []string{"", "ezs4a", "0123456789bcd"} {
+		if _, err := DecodeGeohash(hash); err == nil {
+			t.Errorf("DecodeGeohash(%q) accepted an invalid geohash", hash)
+		}
+	}
+}
+
+func TestGeohashPolyfill(t *testing.T) {
+	// The precision 1 cells are 45 x 45 degrees; this square holds the centres of "s" and "t" only
+	cells, err := GeohashPolyfill(square(0, 0, 90, 45), 1, 100)
+	if err != nil {
+		t.Fatalf("GeohashPolyfill() error = %v", err)
+	}
+	if !slices.Equal(cells, []string{"s", "t"}) {
+		t.Errorf("cells = %v, want [s t]", cells)
+	}
+
+	// A hole over the centre of "t" removes it
+	withHole := MultiPolygon{{square(0, 0, 90, 45)[0][0], square(60, 10, 80, 30)[0][0]}}
+	cells, err = GeohashPolyfill(withHole, 1, 100)
+	if err != nil {
+		t.Fatalf("GeohashPolyfill() error = %v", err)
+	}
+	if !slices.Equal(cells, []string{"s"}) {
+		t.Errorf("cells with hole = %v, want [s]", cells)
+	}
+
+	if _, err := GeohashPolyfill(square(0, 0, 90, 45), 6, 100); err == nil {
+		t.Error("expected an error when the area spans too many cells")
+	}
+}
+
+func TestAreaWithin(t *testing.T) {
+	mp := square(0, 0, 2, 2)
+	if got := mp.AreaWithin(Rect{MinX: 1, MinY: 1, MaxX: 3, MaxY: 3}); math.Abs(got-1) > 1e-12 {
+		t.Errorf("AreaWithin() = %v, want 1", got)
+	}
+
+	// A concave L shape clipped to its notch keeps only the arm inside the rectangle
+	l := MultiPolygon{{{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}, {0, 0}}}}
+	if got := l.AreaWithin(Rect{MinX: 0.5, MinY: 0.5, MaxX: 2, MaxY: 2}); math.Abs(got-1.25) > 1e-12 {
+		t.Errorf("AreaWithin() of L shape = %v, want 1.25", got)
+	}
+
+	holed := MultiPolygon{{square(0, 0, 2, 2)[0][0], square(0.5, 0.5, 1.5, 1.5)[0][0]}}
+	if got := holed.AreaWithin(Rect{MinX: 0, MinY: 0, MaxX: 2, MaxY: 2}); math.Abs(got-3) > 1e-12 {
+		t.Errorf("AreaWithin() with hole = %v, want 3", got)
+	}
+}
+
+func TestAreaWithinConvex(t *testing.T) {
+	mp := square(0, 0, 2, 2)
+	// The same triangle clockwise and counter-clockwise covers half the square
+	for _, triangle := range []ConvexPolygon{{{0, 0}, {0, 2}, {2, 0}}, {{0, 0}, {2, 0}, {0, 2}, {0, 0}}} {
+		if got := mp.AreaWithinConvex(triangle); math.Abs(got-2) > 1e-12 {
+			t.Errorf("AreaWithinConvex(%v) = %v, want 2", triangle, got)
+		}
+	}
+
+	l := MultiPolygon{{{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}, {0, 0}}}}
+	diamond := ConvexPolygon{{1, 0}, {2, 1}, {1, 2}, {0, 1}}
+	if got := l.AreaWithinConvex(diamond); math.Abs(got-1.5) > 1e-12 {
+		t.Errorf("AreaWithinConvex() of L shape = %v, want 1.5", got)
+	}
+	if got := diamond.Area(); math.Abs(got-2) > 1e-12 {
+		t.Errorf("Area() = %v, want 2", got)
+	}
+}
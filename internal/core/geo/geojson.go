@@ -0,0 +1,197 @@
+package geo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Point is a position in [lon, lat] order, as in GeoJSON
+type Point [2]float64
+
+// Ring is a closed sequence of points where the first and last point are equal
+type Ring []Point
+
+// Polygon is an exterior ring followed by zero or more holes
+type Polygon []Ring
+
+// MultiPolygon is a set of polygons
+type MultiPolygon []Polygon
+
+// LineString is an open sequence of points
+type LineString []Point
+
+// GeometryType identifies a geometry using the OGC simple features codes shared by WKB and GeoPackage
+type GeometryType int
+
+const (
+	GeometryUnknown         GeometryType = 0
+	GeometryPoint           GeometryType = 1
+	GeometryLineString      GeometryType = 2
+	GeometryPolygon         GeometryType = 3
+	GeometryMultiPoint      GeometryType = 4
+	GeometryMultiLineString GeometryType = 5
+	GeometryMultiPolygon    GeometryType = 6
+)
+
+var geometryTypeNames = map[GeometryType]string{
+	GeometryUnknown:         "Geometry",
+	GeometryPoint:           "Point",
+	GeometryLineString:      "LineString",
+	GeometryPolygon:         "Polygon",
+	GeometryMultiPoint:      "MultiPoint",
+	GeometryMultiLineString: "MultiLineString",
+	GeometryMultiPolygon:    "MultiPolygon",
+}
+
+// String returns the GeoJSON name of the geometry type
+func (t GeometryType) String() string {
+	return geometryTypeNames[t]
+}
+
+// Geometry is a decoded GeoJSON geometry of any simple feature type.
+// Single geometries are stored like their multi counterpart with one member:
+// Points holds a Point or MultiPoint, Lines a LineString or MultiLineString and
+// Polygons a Polygon or MultiPolygon.
+type Geometry struct {
+	Type     GeometryType
+	Points   []Point
+	Lines    []LineString
+	Polygons MultiPolygon
+}
+
+// Multi returns the geometry as its multi counterpart, e.g. a Polygon as a MultiPolygon
+func (g Geometry) Multi() Geometry {
+	switch g.Type {
+	case GeometryPoint:
+		g.Type = GeometryMultiPoint
+	case GeometryLineString:
+		g.Type = GeometryMultiLineString
+	case GeometryPolygon:
+		g.Type = GeometryMultiPolygon
+	}
+	return g
+}
+
+// IsEmpty reports whether the geometry has no positions
+func (g Geometry) IsEmpty() bool {
+	return len(g.Points) == 0 && len(g.Lines) == 0 && len(g.Polygons) == 0
+}
+
+// Bounds returns the bounding box of the geometry as minX, minY, maxX, maxY
+func (g Geometry) Bounds() (minX, minY, maxX, maxY float64) {
+	minX, minY, maxX, maxY = g.Polygons.Bounds()
+	expand := func(p Point) {
+		minX = math.Min(minX, p[0])
+		minY = math.Min(minY, p[1])
+		maxX = math.Max(maxX, p[0])
+		maxY = math.Max(maxY, p[1])
+	}
+	for _, p := range g.Points {
+		expand(p)
+	}
+	for _, line := range g.Lines {
+		for _, p := range line {
+			expand(p)
+		}
+	}
+	return minX, minY, maxX, maxY
+}
+
+type geoJSONGeometry struct {
+	Type        string          `json:"type"`
+	Coordinates json.RawMessage `json:"coordinates"`
+}
+
+// ParseMultiPolygon decodes a GeoJSON Polygon or MultiPolygon geometry.
+// A Polygon is returned as a MultiPolygon with a single member.
+func ParseMultiPolygon(data []byte) (MultiPolygon, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty geometry")
+	}
+
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return nil, err
+	}
+
+	switch g.Type {
+	case "Polygon":
+		var p Polygon
+		if err := json.Unmarshal(g.Coordinates, &p); err != nil {
+			return nil, err
+		}
+		return MultiPolygon{p}, nil
+	case "MultiPolygon":
+		var mp MultiPolygon
+		if err := json.Unmarshal(g.Coordinates, &mp); err != nil {
+			return nil, err
+		}
+		return mp, nil
+	default:
+		return nil, fmt.Errorf("unsupported geometry type: %s", g.Type)
+	}
+}
+
+// ParseGeometry decodes a GeoJSON Point, LineString, Polygon or their multi counterparts
+func ParseGeometry(data []byte) (Geometry, error) {
+	if len(data) == 0 {
+		return Geometry{}, errors.New("empty geometry")
+	}
+
+	var g geoJSONGeometry
+	if err := json.Unmarshal(data, &g); err != nil {
+		return Geometry{}, err
+	}
+
+	var (
+		out Geometry
+		err error
+	)
+	switch g.Type {
+	case "Point":
+		var p Point
+		err = json.Unmarshal(g.Coordinates, &p)
+		out = Geometry{Type: GeometryPoint, Points: []Point{p}}
+	case "MultiPoint":
+		out.Type = GeometryMultiPoint
+		err = json.Unmarshal(g.Coordinates, &out.Points)
+	case "LineString":
+		var line LineString
+		err = json.Unmarshal(g.Coordinates, &line)
+		out = Geometry{Type: GeometryLineString, Lines: []LineString{line}}
+	case "MultiLineString":
+		out.Type = GeometryMultiLineString
+		err = json.Unmarshal(g.Coordinates, &out.Lines)
+	case "Polygon", "MultiPolygon":
+		out.Polygons, err = ParseMultiPolygon(data)
+		out.Type = GeometryMultiPolygon
+		if g.Type == "Polygon" {
+			out.Type = GeometryPolygon
+		}
+	default:
+		return Geometry{}, fmt.Errorf("unsupported geometry type: %s", g.Type)
+	}
+	if err != nil {
+		return Geometry{}, err
+	}
+	return out, nil
+}
+
+// Bounds returns the bounding box of the multipolygon as minX, minY, maxX, maxY
+func (mp MultiPolygon) Bounds() (minX, minY, maxX, maxY float64) {
+	minX, minY = math.Inf(1), math.Inf(1)
+	maxX, maxY = math.Inf(-1), math.Inf(-1)
+	for _, polygon := range mp {
+		for _, ring := range polygon {
+			for _, p := range ring {
+				minX = math.Min(minX, p[0])
+				minY = math.Min(minY, p[1])
+				maxX = math.Max(maxX, p[0])
+				maxY = math.Max(maxY, p[1])
+			}
+		}
+	}
+	return minX, minY, maxX, maxY
+}
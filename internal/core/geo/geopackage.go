@@ -0,0 +1,179 @@
+package geo
+
+import (
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	// The SQLite driver writing GeoPackages
+	_ "modernc.org/sqlite"
+)
+
+const (
+	// geoPackageApplicationID is "GPKG" as stored in the SQLite application_id
+	geoPackageApplicationID = 0x47504B47
+	// geoPackageVersion is GeoPackage 1.3.0 as stored in the SQLite user_version
+	geoPackageVersion = 10300
+)
+
+const wgs84WKT = `GEOGCS["WGS 84",DATUM["WGS_1984",SPHEROID["WGS 84",6378137,298.257223563,AUTHORITY["EPSG","7030"]],AUTHORITY["EPSG","6326"]],PRIMEM["Greenwich",0,AUTHORITY["EPSG","8901"]],UNIT["degree",0.0174532925199433,AUTHORITY["EPSG","9122"]],AUTHORITY["EPSG","4326"]]`
+
+// The required GeoPackage tables, as defined by the specification
+const (
+	createSpatialRefSys = `CREATE TABLE gpkg_spatial_ref_sys (srs_name TEXT NOT NULL, srs_id INTEGER NOT NULL PRIMARY KEY, organization TEXT NOT NULL, organization_coordsys_id INTEGER NOT NULL, definition TEXT NOT NULL, description TEXT)`
+	createContents      = `CREATE TABLE gpkg_contents (table_name TEXT NOT NULL PRIMARY KEY, data_type TEXT NOT NULL, identifier TEXT UNIQUE, description TEXT DEFAULT '', last_change DATETIME NOT NULL DEFAULT (strftime('%Y-%m-%dT%H:%M:%fZ','now')), min_x DOUBLE, min_y DOUBLE, max_x DOUBLE, max_y DOUBLE, srs_id INTEGER, CONSTRAINT fk_gc_r_srs_id FOREIGN KEY (srs_id) REFERENCES gpkg_spatial_ref_sys(srs_id))`
+	createGeometryCols  = `CREATE TABLE gpkg_geometry_columns (table_name TEXT NOT NULL, column_name TEXT NOT NULL, geometry_type_name TEXT NOT NULL, srs_id INTEGER NOT NULL, z TINYINT NOT NULL, m TINYINT NOT NULL, CONSTRAINT pk_geom_cols PRIMARY KEY (table_name, column_name), CONSTRAINT uk_gc_table_name UNIQUE (table_name), CONSTRAINT fk_gc_tn FOREIGN KEY (table_name) REFERENCES gpkg_contents(table_name), CONSTRAINT fk_gc_srs FOREIGN KEY (srs_id) REFERENCES gpkg_spatial_ref_sys (srs_id))`
+)
+
+var geoPackageColumnTypes = map[ColumnType]string{
+	ColumnString: "TEXT",
+	ColumnInt:    "INTEGER",
+	ColumnFloat:  "DOUBLE",
+}
+
+// WriteGeoPackage encodes the layer as a GeoPackage: a SQLite database with the
+// required metadata tables and one feature table named after the layer, with an
+// integer fid primary key and the geometry in the geom column.
+func WriteGeoPackage(w io.Writer, layer Layer) error {
+	return StreamGeoPackage(w, layer, layer.Each)
+}
+
+// StreamGeoPackage encodes the records of source as WriteGeoPackage does, taking the
+// name, geometry type and columns from layer. The database is written by SQLite to a
+// temporary file, which is copied to w once the extent is recorded.
+func StreamGeoPackage(w io.Writer, layer Layer, source RecordSource) error {
+	file, err := os.CreateTemp("", "gapi-*.gpkg")
+	if err != nil {
+		return err
+	}
+	path := file.Name()
+	defer os.Remove(path)
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	if err := buildGeoPackage(path, layer, source); err != nil {
+		return err
+	}
+
+	file, err = os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// buildGeoPackage creates the GeoPackage at path. The file is thrown away on failure,
+// so SQLite keeps no journal and does not sync.
+func buildGeoPackage(path string, layer Layer, source RecordSource) error {
+	db, err := sql.Open("sqlite", "file:"+path+"?_pragma=journal_mode(OFF)&_pragma=synchronous(OFF)")
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	// Pragmas and the transaction belong to one connection
+	db.SetMaxOpenConns(1)
+
+	geometryTypeName := strings.ToUpper(layer.GeometryType.String())
+	columns := []string{`"fid" INTEGER PRIMARY KEY`, `"geom" ` + geometryTypeName}
+	placeholders := []string{"?", "?"}
+	for _, column := range layer.Columns {
+		columns = append(columns, fmt.Sprintf("%s %s", quoteIdentifier(column.Name), geoPackageColumnTypes[column.Type]))
+		placeholders = append(placeholders, "?")
+	}
+
+	statements := []string{
+		fmt.Sprintf("PRAGMA application_id = %d", geoPackageApplicationID),
+		fmt.Sprintf("PRAGMA user_version = %d", geoPackageVersion),
+		createSpatialRefSys,
+		createContents,
+		createGeometryCols,
+		fmt.Sprintf("CREATE TABLE %s (%s)", quoteIdentifier(layer.Name), strings.Join(columns, ", ")),
+	}
+	for _, statement := range statements {
+		if _, err := db.Exec(statement); err != nil {
+			return err
+		}
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	srs := [][]any{
+		{"Undefined cartesian SRS", -1, "NONE", -1, "undefined", "undefined cartesian coordinate reference system"},
+		{"Undefined geographic SRS", 0, "NONE", 0, "undefined", "undefined geographic coordinate reference system"},
+		{"WGS 84 geodetic", 4326, "EPSG", 4326, wgs84WKT, "longitude/latitude coordinates in decimal degrees on the WGS 84 spheroid"},
+	}
+	for _, row := range srs {
+		if _, err := tx.Exec("INSERT INTO gpkg_spatial_ref_sys VALUES (?, ?, ?, ?, ?, ?)", row...); err != nil {
+			return err
+		}
+	}
+
+	insert, err := tx.Prepare(fmt.Sprintf("INSERT INTO %s VALUES (%s)", quoteIdentifier(layer.Name), strings.Join(placeholders, ", ")))
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	var count int64
+	extent := newExtent()
+	err = source(func(r Record) error {
+		count++
+		extent.add(r.Geometry)
+		_, err := insert.Exec(append([]any{count, geoPackageGeometry(r.Geometry)}, r.Values...)...)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	bounds := []any{nil, nil, nil, nil}
+	if count > 0 {
+		bounds = []any{extent[0], extent[1], extent[2], extent[3]}
+	}
+	lastChange := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
+	contents := append([]any{layer.Name, "features", layer.Name, "", lastChange}, append(bounds, 4326)...)
+	if _, err := tx.Exec("INSERT INTO gpkg_contents VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", contents...); err != nil {
+		return err
+	}
+	if _, err := tx.Exec("INSERT INTO gpkg_geometry_columns VALUES (?, ?, ?, ?, ?, ?)", layer.Name, "geom", geometryTypeName, 4326, 0, 0); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return db.Close()
+}
+
+// geoPackageGeometry encodes a GeoPackage geometry blob: the GP header with the
+// SRS id and XY envelope, followed by the WKB geometry
+func geoPackageGeometry(g Geometry) []byte {
+	buf := []byte{'G', 'P', 0}
+	if g.IsEmpty() {
+		buf = append(buf, 0x11) // little endian, no envelope, empty
+		buf = binary.LittleEndian.AppendUint32(buf, 4326)
+		return AppendWKB(buf, g)
+	}
+
+	buf = append(buf, 0x03) // little endian, XY envelope
+	buf = binary.LittleEndian.AppendUint32(buf, 4326)
+	minX, minY, maxX, maxY := g.Bounds()
+	for _, v := range []float64{minX, maxX, minY, maxY} {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+	}
+	return AppendWKB(buf, g)
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,211 @@
+package geo
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/binary"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	_ "modernc.org/sqlite"
+)
+
+func TestWriteGeoPackageHeader(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGeoPackage(&buf, testLayer(2000)); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if string(data[:16]) != "SQLite format 3\x00" {
+		t.Fatalf("unexpected file header %q", data[:16])
+	}
+	pageSize := int(binary.BigEndian.Uint16(data[16:]))
+	if pages := binary.BigEndian.Uint32(data[28:]); int(pages)*pageSize != len(data) {
+		t.Errorf("header declares %d pages of %d bytes for %d bytes", pages, pageSize, len(data))
+	}
+	if id := binary.BigEndian.Uint32(data[68:]); id != geoPackageApplicationID {
+		t.Errorf("unexpected application_id %x", id)
+	}
+	if version := binary.BigEndian.Uint32(data[60:]); version != geoPackageVersion {
+		t.Errorf("unexpected user_version %d", version)
+	}
+}
+
+func TestGeoPackageGeometry(t *testing.T) {
+	blob := geoPackageGeometry(Geometry{Type: GeometryMultiPolygon, Polygons: square(1, 2, 3, 4)})
+
+	if string(blob[:3]) != "GP\x00" || blob[3] != 0x03 {
+		t.Fatalf("unexpected header %v", blob[:4])
+	}
+	if srs := binary.LittleEndian.Uint32(blob[4:]); srs != 4326 {
+		t.Errorf("unexpected srs id %d", srs)
+	}
+	envelope := make([]float64, 4)
+	for i := range envelope {
+		envelope[i] = math.Float64frombits(binary.LittleEndian.Uint64(blob[8+8*i:]))
+	}
+	if envelope[0] != 1 || envelope[1] != 3 || envelope[2] != 2 || envelope[3] != 4 {
+		t.Errorf("expected envelope as minx, maxx, miny, maxy, got %v", envelope)
+	}
+	// WKB: byte order, MultiPolygon, one polygon, which is itself a Polygon with one ring of 5 points
+	wkb := blob[40:]
+	if wkb[0] != 1 || binary.LittleEndian.Uint32(wkb[1:]) != 6 || binary.LittleEndian.Uint32(wkb[5:]) != 1 {
+		t.Errorf("unexpected WKB header %v", wkb[:9])
+	}
+	if len(wkb) != 9+5+4+4+5*16 {
+		t.Errorf("unexpected WKB length %d", len(wkb))
+	}
+}
+
+// openGeoPackage writes the layer to a file and opens it with SQLite
+func openGeoPackage(t *testing.T, layer Layer) *sql.DB {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := WriteGeoPackage(&buf, layer); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), layer.Name+".gpkg")
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("sqlite", "file:"+path+"?mode=ro")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	var result string
+	if err := db.QueryRow("PRAGMA integrity_check").Scan(&result); err != nil {
+		t.Fatal(err)
+	}
+	if result != "ok" {
+		t.Fatalf("integrity_check: %s", result)
+	}
+	return db
+}
+
+// assertFeatures reads the feature table back and compares it with the records
+func assertFeatures(t *testing.T, db *sql.DB, layer Layer) {
+	t.Helper()
+	rows, err := db.Query(`SELECT fid, geom, name, population, density FROM "` + layer.Name + `" ORDER BY fid`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	n := 0
+	for ; rows.Next(); n++ {
+		var fid int64
+		var geom []byte
+		var name sql.NullString
+		var population sql.NullInt64
+		var density sql.NullFloat64
+		if err := rows.Scan(&fid, &geom, &name, &population, &density); err != nil {
+			t.Fatal(err)
+		}
+		if n >= len(layer.Records) {
+			continue
+		}
+		r := layer.Records[n]
+		if fid != int64(n+1) {
+			t.Errorf("row %d has fid %d", n, fid)
+		}
+		if !bytes.Equal(geom, geoPackageGeometry(r.Geometry)) {
+			t.Errorf("geometry of feature %d does not round-trip", fid)
+		}
+		if want, _ := r.Values[0].(string); name.String != want || name.Valid != (r.Values[0] != nil) {
+			t.Errorf("name of feature %d is %q, want %q", fid, name.String, want)
+		}
+		if want, _ := r.Values[1].(int64); population.Int64 != want || population.Valid != (r.Values[1] != nil) {
+			t.Errorf("population of feature %d is %v, want %v", fid, population, r.Values[1])
+		}
+		if want, _ := r.Values[2].(float64); density.Float64 != want || density.Valid != (r.Values[2] != nil) {
+			t.Errorf("density of feature %d is %v, want %v", fid, density, r.Values[2])
+		}
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != len(layer.Records) {
+		t.Errorf("read %d features, want %d", n, len(layer.Records))
+	}
+}
+
+func TestWriteGeoPackageRoundTrip(t *testing.T) {
+	layer := testLayer(20000)
+	for i := range layer.Records {
+		if i%3 == 0 {
+			layer.Records[i].Values[2] = float64(i) / 7
+		}
+	}
+	db := openGeoPackage(t, layer)
+	assertFeatures(t, db, layer)
+
+	var tableName, dataType, geometryType string
+	var minX, minY, maxX, maxY float64
+	var srsID int64
+	err := db.QueryRow(`SELECT c.table_name, c.data_type, c.min_x, c.min_y, c.max_x, c.max_y, c.srs_id, g.geometry_type_name
+		FROM gpkg_contents c JOIN gpkg_geometry_columns g ON g.table_name = c.table_name`).
+		Scan(&tableName, &dataType, &minX, &minY, &maxX, &maxY, &srsID, &geometryType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tableName != "admin1" || dataType != "features" || srsID != 4326 || geometryType != "MULTIPOLYGON" {
+		t.Errorf("unexpected contents %s %s %d %s", tableName, dataType, srsID, geometryType)
+	}
+	if minX != 0 || minY != 0 || maxX != 9.5 || maxY != 1999.5 {
+		t.Errorf("unexpected extent %v %v %v %v", minX, minY, maxX, maxY)
+	}
+
+	var definition string
+	if err := db.QueryRow("SELECT definition FROM gpkg_spatial_ref_sys WHERE srs_id = 4326").Scan(&definition); err != nil {
+		t.Fatal(err)
+	}
+	if definition != wgs84WKT {
+		t.Errorf("unexpected WGS 84 definition %q", definition)
+	}
+
+	var applicationID, userVersion int64
+	db.QueryRow("PRAGMA application_id").Scan(&applicationID)
+	db.QueryRow("PRAGMA user_version").Scan(&userVersion)
+	if applicationID != geoPackageApplicationID || userVersion != geoPackageVersion {
+		t.Errorf("unexpected application_id %x or user_version %d", applicationID, userVersion)
+	}
+}
+
+func TestWriteGeoPackageLargeFeatures(t *testing.T) {
+	layer := testLayer(0)
+	// A large geometry with multi-byte text, between small features
+	ring := make(Ring, 0, 5001)
+	for i := 0; i < 5000; i++ {
+		angle := 2 * math.Pi * float64(i) / 5000
+		ring = append(ring, Point{100 + math.Cos(angle), 13 + math.Sin(angle)})
+	}
+	layer.Records = append(layer.Records, testLayer(20).Records...)
+	layer.Records = append(layer.Records, Record{
+		Geometry: Geometry{Type: GeometryMultiPolygon, Polygons: MultiPolygon{{append(ring, ring[0])}}},
+		Values:   []any{strings.Repeat("กรุงเทพ", 1000), int64(5000), 1.5},
+	})
+	layer.Records = append(layer.Records, testLayer(20).Records...)
+
+	db := openGeoPackage(t, layer)
+	assertFeatures(t, db, layer)
+}
+
+func TestWriteGeoPackageEmptyLayer(t *testing.T) {
+	layer := testLayer(0)
+	db := openGeoPackage(t, layer)
+	assertFeatures(t, db, layer)
+
+	var minX sql.NullFloat64
+	if err := db.QueryRow("SELECT min_x FROM gpkg_contents").Scan(&minX); err != nil {
+		t.Fatal(err)
+	}
+	if minX.Valid {
+		t.Errorf("expected no extent for an empty layer, got %v", minX.Float64)
+	}
+}
@@ -0,0 +1,96 @@
+//go:build cgo
+
+package geo
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/uber/h3-go/v4"
+)
+
+// MaxH3Resolution is the finest H3 resolution
+const MaxH3Resolution = h3.MaxResolution
+
+// kmPerDegree is the length of a degree of latitude, and of longitude at the equator
+const kmPerDegree = 111.32
+
+// H3Polyfill returns the H3 cells whose centre lies inside the multipolygon, sorted.
+// It fails when the bounding box, measured in average cells of the resolution, holds
+// more than maxCells cells.
+func H3Polyfill(mp MultiPolygon, resolution int, maxCells int) ([]string, error) {
+	if resolution < 0 || resolution > MaxH3Resolution {
+		return nil, fmt.Errorf("H3 resolution must be between 0 and %d", MaxH3Resolution)
+	}
+	if len(mp) == 0 {
+		return []string{}, nil
+	}
+
+	minX, minY, maxX, maxY := mp.Bounds()
+	// Longitude degrees are widest at the latitude of the box closest to the equator
+	widest := 0.0
+	if minY > 0 || maxY < 0 {
+		widest = math.Min(math.Abs(minY), math.Abs(maxY))
+	}
+	boxKm2 := (maxX - minX) * kmPerDegree * math.Cos(widest*math.Pi/180) * (maxY - minY) * kmPerDegree
+	if boxKm2/h3.HexagonAreaAvgKm2(resolution) > float64(maxCells) {
+		return nil, fmt.Errorf("area spans more than %d cells at resolution %d", maxCells, resolution)
+	}
+
+	seen := make(map[h3.Cell]bool)
+	cells := []string{}
+	for _, polygon := range mp {
+		if len(polygon) == 0 || len(openRing(polygon[0])) < 3 {
+			continue
+		}
+		geoPolygon := h3.GeoPolygon{GeoLoop: h3Loop(polygon[0])}
+		for _, hole := range polygon[1:] {
+			geoPolygon.Holes = append(geoPolygon.Holes, h3Loop(hole))
+		}
+		for _, cell := range h3.PolygonToCells(geoPolygon, resolution) {
+			// Polygons of a multipolygon may share the cells along their common border
+			if cell == 0 || seen[cell] {
+				continue
+			}
+			seen[cell] = true
+			cells = append(cells, cell.String())
+		}
+	}
+	sort.Strings(cells)
+	return cells, nil
+}
+
+// DecodeH3 returns the canonical form of an H3 cell index and its boundary. Cells are
+// convex on the sphere and close enough to it in degrees at the sizes admin areas are
+// indexed with. Cells crossing the antimeridian, which includes those holding a pole,
+// have no planar boundary and are rejected.
+func DecodeH3(index string) (string, ConvexPolygon, error) {
+	cell := h3.Cell(h3.IndexFromString(strings.TrimSpace(index)))
+	if !cell.IsValid() {
+		return "", nil, fmt.Errorf("invalid H3 cell %q", index)
+	}
+
+	boundary := h3.CellToBoundary(cell)
+	points := make(ConvexPolygon, len(boundary))
+	minX, maxX := math.Inf(1), math.Inf(-1)
+	for i, latLng := range boundary {
+		points[i] = Point{latLng.Lng, latLng.Lat}
+		minX, maxX = math.Min(minX, latLng.Lng), math.Max(maxX, latLng.Lng)
+	}
+	if maxX-minX > 180 {
+		return "", nil, fmt.Errorf("H3 cell %s crosses the antimeridian or a pole", cell)
+	}
+	return cell.String(), points, nil
+}
+
+// h3Loop converts a ring to an H3 loop, which is implicitly closed
+func h3Loop(ring Ring) h3.GeoLoop {
+	points := openRing(ring)
+	loop := make(h3.GeoLoop, len(points))
+	for i, p := range points {
+		loop[i] = h3.LatLng{Lat: p[1], Lng: p[0]}
+	}
+	return loop
+}
@@ -0,0 +1,21 @@
+//go:build !cgo
+
+package geo
+
+import "errors"
+
+// MaxH3Resolution is the finest H3 resolution
+const MaxH3Resolution = 15
+
+// errNoH3 is returned by the H3 functions of builds without cgo, which the H3 library needs
+var errNoH3 = errors.New("H3 cells need a build with cgo")
+
+// H3Polyfill is unavailable without cgo
+func H3Polyfill(mp MultiPolygon, resolution int, maxCells int) ([]string, error) {
+	return nil, errNoH3
+}
+
+// DecodeH3 is unavailable without cgo
+func DecodeH3(index string) (string, ConvexPolygon, error) {
+	return "", nil, errNoH3
+}
@@ -0,0 +1,73 @@
+//go:build cgo
+
+package geo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDecodeH3(t *testing.T) {
+	cell, boundary, err := DecodeH3("0x8928308280FFFFF")
+	if err != nil {
+		t.Fatalf("DecodeH3() error = %v", err)
+	}
+	if cell != "8928308280fffff" {
+		t.Errorf("cell = %s, want the lower-case index without prefix", cell)
+	}
+	if len(boundary) != 6 {
+		t.Fatalf("boundary has %d points, want 6", len(boundary))
+	}
+	// The cell is in San Francisco, centred on 37.7767N 122.4185W
+	hexagon := MultiPolygon{{append(Ring(slices.Clone(boundary)), boundary[0])}}
+	if got := hexagon.AreaWithin(Rect{MinX: -122.4186, MinY: 37.7766, MaxX: -122.4184, MaxY: 37.7768}); got <= 0 {
+		t.Errorf("boundary %v does not contain the cell centre", boundary)
+	}
+
+	for _, index := range []string{"", "zz", "8928308280fffff0", "8f28308280fffff"} {
+		if _, _, err := DecodeH3(index); err == nil {
+			t.Errorf("expected an error for %q", index)
+		}
+	}
+}
+
+func TestH3Polyfill(t *testing.T) {
+	_, boundary, err := DecodeH3("8928308280fffff")
+	if err != nil {
+		t.Fatalf("DecodeH3() error = %v", err)
+	}
+	hexagon := Ring(append(slices.Clone(boundary), boundary[0]))
+
+	// Only the cell's own centre lies inside its boundary
+	cells, err := H3Polyfill(MultiPolygon{{hexagon}}, 9, 100)
+	if err != nil {
+		t.Fatalf("H3Polyfill() error = %v", err)
+	}
+	if !slices.Equal(cells, []string{"8928308280fffff"}) {
+		t.Errorf("cells = %v, want [8928308280fffff]", cells)
+	}
+
+	// Finer cells of the same area are counted once across overlapping polygons, and
+	// a hole over the whole area leaves nothing
+	fine, err := H3Polyfill(MultiPolygon{{hexagon}, {hexagon}}, 11, 1000)
+	if err != nil {
+		t.Fatalf("H3Polyfill() error = %v", err)
+	}
+	if len(fine) < 40 || len(fine) > 60 || !slices.IsSorted(fine) || len(slices.Compact(slices.Clone(fine))) != len(fine) {
+		t.Errorf("got %d cells at resolution 11, want about 49 distinct sorted cells", len(fine))
+	}
+	holed, err := H3Polyfill(MultiPolygon{{square(-122.43, 37.76, -122.40, 37.79)[0][0], hexagon}}, 9, 100)
+	if err != nil {
+		t.Fatalf("H3Polyfill() error = %v", err)
+	}
+	if slices.Contains(holed, "8928308280fffff") {
+		t.Errorf("cells with hole = %v, want the cell under the hole left out", holed)
+	}
+
+	if _, err := H3Polyfill(square(0, 0, 90, 45), 9, 100); err == nil {
+		t.Error("expected an error when the area spans too many cells")
+	}
+	if _, err := H3Polyfill(MultiPolygon{{hexagon}}, 16, 100); err == nil {
+		t.Error("expected an error for an invalid resolution")
+	}
+}
@@ -0,0 +1,198 @@
+package geo
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strconv"
+)
+
+var kmlColumnTypes = map[ColumnType]string{
+	ColumnString: "string",
+	ColumnInt:    "int",
+	ColumnFloat:  "double",
+}
+
+// KMLWriter streams records as KML placemarks. Attributes are written as typed
+// ExtendedData against a Schema named after the layer, and the "name" column,
+// when present, also becomes the placemark name shown by viewers.
+type KMLWriter struct {
+	w       *bufio.Writer
+	name    string
+	columns []Column
+	err     error
+}
+
+// NewKMLWriter writes the document header and schema; call Close to finish the document
+func NewKMLWriter(w io.Writer, name string, columns []Column) *KMLWriter {
+	k := &KMLWriter{w: bufio.NewWriter(w), name: name, columns: columns}
+	k.str(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	k.str(`<kml xmlns="http://www.opengis.net/kml/2.2"><Document><name>`)
+	k.text(name)
+	k.str(`</name><Schema name="`)
+	k.text(name)
+	k.str(`" id="`)
+	k.text(name)
+	k.str(`">`)
+	for _, column := range columns {
+		k.str(`<SimpleField name="`)
+		k.text(column.Name)
+		k.str(`" type="` + kmlColumnTypes[column.Type] + `"/>`)
+	}
+	k.str("</Schema>\n")
+	return k
+}
+
+// Write appends a record as a placemark
+func (k *KMLWriter) Write(r Record) error {
+	k.str("<Placemark>")
+	for i, column := range k.columns {
+		if name, ok := r.Values[i].(string); ok && column.Name == "name" {
+			k.str("<name>")
+			k.text(name)
+			k.str("</name>")
+		}
+	}
+
+	k.str(`<ExtendedData><SchemaData schemaUrl="#`)
+	k.text(k.name)
+	k.str(`">`)
+	for i, column := range k.columns {
+		var value string
+		switch v := r.Values[i].(type) {
+		case string:
+			value = v
+		case int64:
+			value = strconv.FormatInt(v, 10)
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		default:
+			continue
+		}
+		k.str(`<SimpleData name="`)
+		k.text(column.Name)
+		k.str(`">`)
+		k.text(value)
+		k.str("</SimpleData>")
+	}
+	k.str("</SchemaData></ExtendedData>")
+
+	k.geometry(r.Geometry)
+	k.str("</Placemark>\n")
+	return k.err
+}
+
+// Close finishes the document and flushes it to the underlying writer
+func (k *KMLWriter) Close() error {
+	k.str("</Document></kml>\n")
+	if k.err != nil {
+		return k.err
+	}
+	return k.w.Flush()
+}
+
+func (k *KMLWriter) geometry(g Geometry) {
+	switch g.Type {
+	case GeometryPoint:
+		if len(g.Points) > 0 {
+			k.point(g.Points[0])
+		}
+	case GeometryLineString:
+		if len(g.Lines) > 0 {
+			k.lineString(g.Lines[0])
+		}
+	case GeometryPolygon:
+		if len(g.Polygons) > 0 {
+			k.polygon(g.Polygons[0])
+		}
+	case GeometryMultiPoint:
+		k.str("<MultiGeometry>")
+		for _, p := range g.Points {
+			k.point(p)
+		}
+		k.str("</MultiGeometry>")
+	case GeometryMultiLineString:
+		k.str("<MultiGeometry>")
+		for _, line := range g.Lines {
+			k.lineString(line)
+		}
+		k.str("</MultiGeometry>")
+	case GeometryMultiPolygon:
+		k.str("<MultiGeometry>")
+		for _, polygon := range g.Polygons {
+			k.polygon(polygon)
+		}
+		k.str("</MultiGeometry>")
+	}
+}
+
+func (k *KMLWriter) point(p Point) {
+	k.str("<Point>")
+	k.coordinates([]Point{p})
+	k.str("</Point>")
+}
+
+func (k *KMLWriter) lineString(line LineString) {
+	k.str("<LineString>")
+	k.coordinates(line)
+	k.str("</LineString>")
+}
+
+func (k *KMLWriter) polygon(polygon Polygon) {
+	k.str("<Polygon>")
+	for i, ring := range polygon {
+		boundary := "innerBoundaryIs"
+		if i == 0 {
+			boundary = "outerBoundaryIs"
+		}
+		k.str("<" + boundary + "><LinearRing>")
+		k.coordinates(ring)
+		k.str("</LinearRing></" + boundary + ">")
+	}
+	k.str("</Polygon>")
+}
+
+func (k *KMLWriter) coordinates(points []Point) {
+	k.str("<coordinates>")
+	buf := make([]byte, 0, 48)
+	for i, p := range points {
+		buf = buf[:0]
+		if i > 0 {
+			buf = append(buf, ' ')
+		}
+		buf = strconv.AppendFloat(buf, p[0], 'f', -1, 64)
+		buf = append(buf, ',')
+		buf = strconv.AppendFloat(buf, p[1], 'f', -1, 64)
+		k.bytes(buf)
+	}
+	k.str("</coordinates>")
+}
+
+func (k *KMLWriter) str(s string) {
+	if k.err == nil {
+		_, k.err = k.w.WriteString(s)
+	}
+}
+
+func (k *KMLWriter) bytes(b []byte) {
+	if k.err == nil {
+		_, k.err = k.w.Write(b)
+	}
+}
+
+func (k *KMLWriter) text(s string) {
+	if k.err == nil {
+		k.err = xml.EscapeText(k.w, []byte(s))
+	}
+}
+
+// WriteKML encodes the whole layer as a KML document
+func WriteKML(w io.Writer, layer Layer) error {
+	k := NewKMLWriter(w, layer.Name, layer.Columns)
+	for _, r := range layer.Records {
+		if err := k.Write(r); err != nil {
+			return err
+		}
+	}
+	return k.Close()
+}
@@ -0,0 +1,53 @@
+package geo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestWriteKML(t *testing.T) {
+	layer := Layer{
+		Name:         "roads",
+		GeometryType: GeometryMultiLineString,
+		Columns:      []Column{{"name", ColumnString}, {"lanes", ColumnInt}},
+		Records: []Record{
+			{
+				Geometry: Geometry{Type: GeometryMultiLineString, Lines: []LineString{{{100.5, 13.75}, {100.6, 13.7}}}},
+				Values:   []any{"ถนน <สุขุมวิท> & Co", int64(4)},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteKML(&buf, layer); err != nil {
+		t.Fatal(err)
+	}
+
+	var doc struct {
+		Document struct {
+			Placemarks []struct {
+				Name        string   `xml:"name"`
+				SimpleData  []string `xml:"ExtendedData>SchemaData>SimpleData"`
+				Coordinates string   `xml:"MultiGeometry>LineString>coordinates"`
+			} `xml:"Placemark"`
+		} `xml:"Document"`
+	}
+	if err := xml.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("output is not valid XML: %v\n%s", err, buf.String())
+	}
+	if len(doc.Document.Placemarks) != 1 {
+		t.Fatalf("expected 1 placemark, got %d", len(doc.Document.Placemarks))
+	}
+	placemark := doc.Document.Placemarks[0]
+	if placemark.Name != "ถนน <สุขุมวิท> & Co" {
+		t.Errorf("unexpected name %q", placemark.Name)
+	}
+	if strings.Join(placemark.SimpleData, "|") != "ถนน <สุขุมวิท> & Co|4" {
+		t.Errorf("unexpected extended data %v", placemark.SimpleData)
+	}
+	if placemark.Coordinates != "100.5,13.75 100.6,13.7" {
+		t.Errorf("unexpected coordinates %q", placemark.Coordinates)
+	}
+}
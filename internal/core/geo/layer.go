@@ -0,0 +1,71 @@
+package geo
+
+// ColumnType is the type of an attribute column in tabular export formats
+type ColumnType int
+
+const (
+	ColumnString ColumnType = iota
+	ColumnInt
+	ColumnFloat
+)
+
+// Column describes an attribute shared by every record of a layer
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Record is a feature of a layer. Values holds one entry per column:
+// a string, int64 or float64 matching the column type, or nil when unset.
+type Record struct {
+	Geometry Geometry
+	Values   []any
+}
+
+// Layer is a named set of records with the same columns and geometry type,
+// the unit written by the file format encoders
+type Layer struct {
+	Name         string
+	GeometryType GeometryType
+	Columns      []Column
+	Records      []Record
+}
+
+// Bounds returns the bounding box of all records as minX, minY, maxX, maxY
+func (l Layer) Bounds() (minX, minY, maxX, maxY float64) {
+	extent := newExtent()
+	for _, r := range l.Records {
+		extent.add(r.Geometry)
+	}
+	return extent[0], extent[1], extent[2], extent[3]
+}
+
+// Each calls fn for every record of the layer, as a RecordSource
+func (l Layer) Each(fn func(Record) error) error {
+	for _, r := range l.Records {
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordSource calls fn for each record in turn, stopping at the first error.
+// The streaming encoders read their records from one, so a layer never has to be
+// held in memory as a whole.
+type RecordSource func(fn func(Record) error) error
+
+// extent is a bounding box as minX, minY, maxX, maxY grown by the geometries added to it
+type extent [4]float64
+
+// newExtent returns an empty extent, inverted to infinity
+func newExtent() extent {
+	var e extent
+	e[0], e[1], e[2], e[3] = MultiPolygon{}.Bounds()
+	return e
+}
+
+func (e *extent) add(g Geometry) {
+	minX, minY, maxX, maxY := g.Bounds()
+	*e = extent{min(e[0], minX), min(e[1], minY), max(e[2], maxX), max(e[3], maxY)}
+}
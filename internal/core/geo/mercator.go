@@ -0,0 +1,38 @@
+package geo
+
+import "math"
+
+// mercatorRadius is the radius of the sphere Web Mercator projects, in metres
+const mercatorRadius = 6378137.0
+
+// WebMercator projects a longitude and latitude to EPSG:3857, the projection the
+// OpenStreetMap tables are stored and searched in. Its metres stretch away from the
+// equator, like the distances measured in it.
+func WebMercator(p Point) Point {
+	x := mercatorRadius * p[0] * math.Pi / 180
+	y := mercatorRadius * math.Log(math.Tan(math.Pi/4+p[1]*math.Pi/360))
+	return Point{x, y}
+}
+
+// LineDistance returns the planar distance from the point to the nearest segment of the
+// lines of the geometry, or +Inf when it has none. Both are in the same projection.
+func (g Geometry) LineDistance(p Point) float64 {
+	distance := math.Inf(1)
+	for _, line := range g.Lines {
+		for i := range line {
+			a, b := line[i], line[min(i+1, len(line)-1)]
+			distance = math.Min(distance, segmentDistance(p, a, b))
+		}
+	}
+	return distance
+}
+
+// segmentDistance returns the distance from p to the segment from a to b
+func segmentDistance(p, a, b Point) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	t := 0.0
+	if length := dx*dx + dy*dy; length > 0 {
+		t = math.Max(0, math.Min(1, ((p[0]-a[0])*dx+(p[1]-a[1])*dy)/length))
+	}
+	return math.Hypot(p[0]-a[0]-t*dx, p[1]-a[1]-t*dy)
+}
@@ -0,0 +1,175 @@
+package geo
+
+import (
+	"math"
+	"sort"
+)
+
+// Crossings returns the sorted x coordinates where the horizontal line at y crosses the
+// rings of the multipolygon. With even-odd filling, points between the first and second
+// crossing are inside, as are those between the third and fourth, and so on; holes are
+// handled by the same rule.
+func (mp MultiPolygon) Crossings(y float64) []float64 {
+	var xs []float64
+	for _, polygon := range mp {
+		for _, ring := range polygon {
+			for i := 0; i+1 < len(ring); i++ {
+				a, b := ring[i], ring[i+1]
+				// Half-open so a vertex on the line is counted once
+				if (a[1] > y) == (b[1] > y) {
+					continue
+				}
+				xs = append(xs, a[0]+(y-a[1])*(b[0]-a[0])/(b[1]-a[1]))
+			}
+		}
+	}
+	sort.Float64s(xs)
+	return xs
+}
+
+// AreaWithin returns the planar area in square degrees of the part of the multipolygon
+// inside the rectangle. Holes are subtracted from their polygon.
+func (mp MultiPolygon) AreaWithin(r Rect) float64 {
+	return mp.clippedArea(func(ring Ring) []Point { return clipRing(ring, r) })
+}
+
+// ConvexPolygon is a convex polygon in WGS84 degrees, as an open ring in either orientation
+type ConvexPolygon []Point
+
+// Area returns the planar area of the polygon in square degrees
+func (c ConvexPolygon) Area() float64 {
+	return ringArea(c)
+}
+
+// AreaWithinConvex returns the planar area in square degrees of the part of the
+// multipolygon inside a convex polygon.
+func (mp MultiPolygon) AreaWithinConvex(clip ConvexPolygon) float64 {
+	return mp.clippedArea(func(ring Ring) []Point { return clipRingConvex(ring, clip) })
+}
+
+// clippedArea sums the areas of the clipped rings, subtracting holes from their polygon
+func (mp MultiPolygon) clippedArea(clip func(Ring) []Point) float64 {
+	var total float64
+	for _, polygon := range mp {
+		for i, ring := range polygon {
+			area := ringArea(clip(ring))
+			if i == 0 {
+				total += area
+			} else {
+				total -= area
+			}
+		}
+	}
+	return math.Max(total, 0)
+}
+
+// ringArea returns the unsigned shoelace area of a ring
+func ringArea(ring []Point) float64 {
+	if len(ring) < 3 {
+		return 0
+	}
+	var area float64
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%len(ring)]
+		area += a[0]*b[1] - b[0]*a[1]
+	}
+	return math.Abs(area) / 2
+}
+
+// clipRing clips a ring to a rectangle with the Sutherland-Hodgman algorithm. The result
+// may contain degenerate edges along the rectangle where a concave ring is split, which
+// do not change its area.
+func clipRing(ring Ring, r Rect) []Point {
+	points := openRing(ring)
+
+	edges := []struct {
+		inside    func(p Point) bool
+		intersect func(a, b Point) Point
+	}{
+		{func(p Point) bool { return p[0] >= r.MinX }, func(a, b Point) Point { return atX(a, b, r.MinX) }},
+		{func(p Point) bool { return p[0] <= r.MaxX }, func(a, b Point) Point { return atX(a, b, r.MaxX) }},
+		{func(p Point) bool { return p[1] >= r.MinY }, func(a, b Point) Point { return atY(a, b, r.MinY) }},
+		{func(p Point) bool { return p[1] <= r.MaxY }, func(a, b Point) Point { return atY(a, b, r.MaxY) }},
+	}
+	for _, edge := range edges {
+		if len(points) == 0 {
+			break
+		}
+		clipped := make([]Point, 0, len(points))
+		prev := points[len(points)-1]
+		for _, p := range points {
+			switch {
+			case edge.inside(p) && edge.inside(prev):
+				clipped = append(clipped, p)
+			case edge.inside(p):
+				clipped = append(clipped, edge.intersect(prev, p), p)
+			case edge.inside(prev):
+				clipped = append(clipped, edge.intersect(prev, p))
+			}
+			prev = p
+		}
+		points = clipped
+	}
+	return points
+}
+
+// clipRingConvex clips a ring to a convex polygon with the Sutherland-Hodgman algorithm,
+// keeping the side of each clip edge that the clip polygon lies on
+func clipRingConvex(ring Ring, convex ConvexPolygon) []Point {
+	points := openRing(ring)
+	clip := openRing(convex)
+	if len(clip) < 3 {
+		return nil
+	}
+	// Positive for a counter-clockwise clip polygon
+	var orientation float64
+	for i := range clip {
+		a, b := clip[i], clip[(i+1)%len(clip)]
+		orientation += a[0]*b[1] - b[0]*a[1]
+	}
+
+	for i := range clip {
+		if len(points) == 0 {
+			break
+		}
+		a, b := clip[i], clip[(i+1)%len(clip)]
+		side := func(p Point) float64 {
+			return ((b[0]-a[0])*(p[1]-a[1]) - (b[1]-a[1])*(p[0]-a[0])) * orientation
+		}
+		intersect := func(p, q Point) Point {
+			t := side(p) / (side(p) - side(q))
+			return Point{p[0] + t*(q[0]-p[0]), p[1] + t*(q[1]-p[1])}
+		}
+		clipped := make([]Point, 0, len(points))
+		prev := points[len(points)-1]
+		for _, p := range points {
+			switch {
+			case side(p) >= 0 && side(prev) >= 0:
+				clipped = append(clipped, p)
+			case side(p) >= 0:
+				clipped = append(clipped, intersect(prev, p), p)
+			case side(prev) >= 0:
+				clipped = append(clipped, intersect(prev, p))
+			}
+			prev = p
+		}
+		points = clipped
+	}
+	return points
+}
+
+// openRing drops the closing point of a ring
+func openRing(ring []Point) []Point {
+	if len(ring) > 1 && ring[0] == ring[len(ring)-1] {
+		return ring[:len(ring)-1]
+	}
+	return ring
+}
+
+func atX(a, b Point, x float64) Point {
+	return Point{x, a[1] + (x-a[0])*(b[1]-a[1])/(b[0]-a[0])}
+}
+
+func atY(a, b Point, y float64) Point {
+	return Point{a[0] + (y-a[1])*(b[0]-a[0])/(b[1]-a[1]), y}
+}
@@ -0,0 +1,303 @@
+package geo
+
+import (
+	"archive/zip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Shape types of the ESRI Shapefile specification
+const (
+	shapeNull       = 0
+	shapePoint      = 1
+	shapePolyLine   = 3
+	shapePolygon    = 5
+	shapeMultiPoint = 8
+)
+
+var shapeTypes = map[GeometryType]int32{
+	GeometryPoint:           shapePoint,
+	GeometryMultiPoint:      shapeMultiPoint,
+	GeometryLineString:      shapePolyLine,
+	GeometryMultiLineString: shapePolyLine,
+	GeometryPolygon:         shapePolygon,
+	GeometryMultiPolygon:    shapePolygon,
+}
+
+// DBF limits: field names are at most 10 characters and character fields at most 254 bytes
+const (
+	dbfMaxFieldName = 10
+	dbfMaxCharWidth = 254
+	dbfIntWidth     = 18
+	dbfFloatWidth   = 24
+	dbfFloatDecimal = 15
+)
+
+const esriWGS84 = `GEOGCS["GCS_WGS_1984",DATUM["D_WGS_1984",SPHEROID["WGS_1984",6378137.0,298.257223563]],PRIMEM["Greenwich",0.0],UNIT["Degree",0.0174532925199433]]`
+
+// WriteShapefile encodes the layer as a zip archive holding the .shp, .shx, .dbf,
+// .prj and .cpg files of an ESRI Shapefile named after the layer. Attribute text is
+// stored as UTF-8, declared in the .cpg file, and truncated on a character boundary
+// to the DBF field width; field names are shortened to 10 characters.
+func WriteShapefile(w io.Writer, layer Layer) error {
+	shapeType, ok := shapeTypes[layer.GeometryType]
+	if !ok {
+		return fmt.Errorf("unsupported shapefile geometry type: %s", layer.GeometryType)
+	}
+
+	shp, shx := encodeShapes(layer, shapeType)
+	files := []struct {
+		ext  string
+		data []byte
+	}{
+		{"shp", shp},
+		{"shx", shx},
+		{"dbf", encodeDBF(layer)},
+		{"prj", []byte(esriWGS84)},
+		{"cpg", []byte("UTF-8")},
+	}
+
+	archive := zip.NewWriter(w)
+	for _, f := range files {
+		entry, err := archive.Create(layer.Name + "." + f.ext)
+		if err != nil {
+			return err
+		}
+		if _, err := entry.Write(f.data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// encodeShapes writes the .shp records and the .shx index pointing at them
+func encodeShapes(layer Layer, shapeType int32) (shp, shx []byte) {
+	records := make([][]byte, len(layer.Records))
+	shpLength, shxLength := 100, 100
+	for i, r := range layer.Records {
+		records[i] = encodeShape(r.Geometry, shapeType)
+		shpLength += 8 + len(records[i])
+		shxLength += 8
+	}
+
+	minX, minY, maxX, maxY := layer.Bounds()
+	if len(layer.Records) == 0 {
+		minX, minY, maxX, maxY = 0, 0, 0, 0
+	}
+	shp = shapeFileHeader(shpLength, shapeType, minX, minY, maxX, maxY)
+	shx = shapeFileHeader(shxLength, shapeType, minX, minY, maxX, maxY)
+
+	for i, record := range records {
+		shx = binary.BigEndian.AppendUint32(shx, uint32(len(shp)/2))
+		shx = binary.BigEndian.AppendUint32(shx, uint32(len(record)/2))
+		shp = binary.BigEndian.AppendUint32(shp, uint32(i+1))
+		shp = binary.BigEndian.AppendUint32(shp, uint32(len(record)/2))
+		shp = append(shp, record...)
+	}
+	return shp, shx
+}
+
+// shapeFileHeader is the 100 byte header shared by .shp and .shx; lengths count 16-bit words
+func shapeFileHeader(length int, shapeType int32, minX, minY, maxX, maxY float64) []byte {
+	header := make([]byte, 100)
+	binary.BigEndian.PutUint32(header[0:], 9994)
+	binary.BigEndian.PutUint32(header[24:], uint32(length/2))
+	binary.LittleEndian.PutUint32(header[28:], 1000)
+	binary.LittleEndian.PutUint32(header[32:], uint32(shapeType))
+	for i, v := range []float64{minX, minY, maxX, maxY} {
+		binary.LittleEndian.PutUint64(header[36+8*i:], math.Float64bits(v))
+	}
+	return header
+}
+
+// encodeShape writes the record content of a geometry. Polygon rings are reoriented
+// as the format requires: outer rings clockwise, holes counter-clockwise.
+func encodeShape(g Geometry, shapeType int32) []byte {
+	if g.IsEmpty() {
+		return binary.LittleEndian.AppendUint32(nil, shapeNull)
+	}
+
+	buf := binary.LittleEndian.AppendUint32(nil, uint32(shapeType))
+	if shapeType == shapePoint {
+		return appendShapePoints(buf, g.Points[:1])
+	}
+
+	minX, minY, maxX, maxY := g.Bounds()
+	for _, v := range []float64{minX, minY, maxX, maxY} {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+	}
+	if shapeType == shapeMultiPoint {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Points)))
+		return appendShapePoints(buf, g.Points)
+	}
+
+	var parts [][]Point
+	if shapeType == shapePolyLine {
+		for _, line := range g.Lines {
+			parts = append(parts, line)
+		}
+	} else {
+		for _, polygon := range g.Polygons {
+			for i, ring := range polygon {
+				parts = append(parts, orientRing(ring, i == 0))
+			}
+		}
+	}
+
+	total := 0
+	for _, part := range parts {
+		total += len(part)
+	}
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(parts)))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(total))
+	start := 0
+	for _, part := range parts {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(start))
+		start += len(part)
+	}
+	for _, part := range parts {
+		buf = appendShapePoints(buf, part)
+	}
+	return buf
+}
+
+func appendShapePoints(buf []byte, points []Point) []byte {
+	for _, p := range points {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p[0]))
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p[1]))
+	}
+	return buf
+}
+
+// orientRing returns the ring clockwise when outer is set and counter-clockwise otherwise
+func orientRing(ring Ring, outer bool) []Point {
+	var area float64
+	for i := 0; i+1 < len(ring); i++ {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	// A positive shoelace area means counter-clockwise
+	if (area > 0) != outer {
+		return ring
+	}
+	reversed := make([]Point, len(ring))
+	for i, p := range ring {
+		reversed[len(ring)-1-i] = p
+	}
+	return reversed
+}
+
+// dbfField is a column as laid out in the DBF table
+type dbfField struct {
+	name     string
+	kind     byte
+	width    int
+	decimals int
+}
+
+// encodeDBF writes the attribute table. Character fields are sized to the longest
+// value so the file stays small, within the 254 byte limit of the format.
+func encodeDBF(layer Layer) []byte {
+	names := dbfFieldNames(layer.Columns)
+	fields := make([]dbfField, len(layer.Columns))
+	for i, column := range layer.Columns {
+		switch column.Type {
+		case ColumnInt:
+			fields[i] = dbfField{names[i], 'N', dbfIntWidth, 0}
+		case ColumnFloat:
+			fields[i] = dbfField{names[i], 'N', dbfFloatWidth, dbfFloatDecimal}
+		default:
+			width := 1
+			for _, r := range layer.Records {
+				if s, ok := r.Values[i].(string); ok {
+					width = max(width, len(s))
+				}
+			}
+			fields[i] = dbfField{names[i], 'C', min(width, dbfMaxCharWidth), 0}
+		}
+	}
+
+	recordLength := 1
+	for _, f := range fields {
+		recordLength += f.width
+	}
+	headerLength := 32 + 32*len(fields) + 1
+
+	now := time.Now()
+	buf := make([]byte, 32, headerLength+recordLength*len(layer.Records)+1)
+	buf[0] = 0x03 // dBase III without memo
+	buf[1], buf[2], buf[3] = byte(now.Year()-1900), byte(now.Month()), byte(now.Day())
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(layer.Records)))
+	binary.LittleEndian.PutUint16(buf[8:], uint16(headerLength))
+	binary.LittleEndian.PutUint16(buf[10:], uint16(recordLength))
+
+	for _, f := range fields {
+		descriptor := make([]byte, 32)
+		copy(descriptor, f.name)
+		descriptor[11] = f.kind
+		descriptor[16] = byte(f.width)
+		descriptor[17] = byte(f.decimals)
+		buf = append(buf, descriptor...)
+	}
+	buf = append(buf, 0x0d)
+
+	for _, r := range layer.Records {
+		buf = append(buf, ' ') // not deleted
+		for i, f := range fields {
+			buf = append(buf, dbfValue(r.Values[i], f)...)
+		}
+	}
+	return append(buf, 0x1a)
+}
+
+// dbfValue formats a value to exactly the field width: text left-aligned, numbers
+// right-aligned, unset values and numbers that do not fit as blanks
+func dbfValue(value any, f dbfField) string {
+	var s string
+	switch v := value.(type) {
+	case string:
+		s = truncateUTF8(v, f.width)
+		return s + strings.Repeat(" ", f.width-len(s))
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'f', f.decimals, 64)
+	}
+	if len(s) > f.width {
+		s = ""
+	}
+	return strings.Repeat(" ", f.width-len(s)) + s
+}
+
+// truncateUTF8 cuts s to at most n bytes without splitting a character, so Thai
+// text is never left with a partial multi-byte sequence
+func truncateUTF8(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// dbfFieldNames shortens column names to the DBF limit, numbering names that collide once shortened
+func dbfFieldNames(columns []Column) []string {
+	names := make([]string, len(columns))
+	used := map[string]bool{}
+	for i, column := range columns {
+		name := truncateUTF8(column.Name, dbfMaxFieldName)
+		for n := 1; used[strings.ToUpper(name)]; n++ {
+			suffix := strconv.Itoa(n)
+			name = truncateUTF8(column.Name, dbfMaxFieldName-len(suffix)) + suffix
+		}
+		used[strings.ToUpper(name)] = true
+		names[i] = name
+	}
+	return names
+}
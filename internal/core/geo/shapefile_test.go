@@ -0,0 +1,102 @@
+package geo
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func unzip(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := map[string][]byte{}
+	for _, f := range reader.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries[f.Name], _ = io.ReadAll(rc)
+		rc.Close()
+	}
+	return entries
+}
+
+func TestWriteShapefile(t *testing.T) {
+	thai := strings.Repeat("กรุงเทพมหานคร", 10) // 390 bytes, over the DBF limit
+	layer := Layer{
+		Name:         "admin1",
+		GeometryType: GeometryMultiPolygon,
+		Columns:      []Column{{"name", ColumnString}, {"admin_level", ColumnInt}, {"admin_levels", ColumnInt}},
+		Records: []Record{
+			{Geometry: Geometry{Type: GeometryMultiPolygon, Polygons: square(0, 0, 1, 1)}, Values: []any{thai, int64(1), nil}},
+			{Geometry: Geometry{Type: GeometryMultiPolygon}, Values: []any{nil, int64(2), int64(3)}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteShapefile(&buf, layer); err != nil {
+		t.Fatal(err)
+	}
+	files := unzip(t, buf.Bytes())
+
+	for _, ext := range []string{"shp", "shx", "dbf", "prj", "cpg"} {
+		if _, ok := files["admin1."+ext]; !ok {
+			t.Errorf("missing admin1.%s", ext)
+		}
+	}
+	if string(files["admin1.cpg"]) != "UTF-8" {
+		t.Errorf("unexpected code page %q", files["admin1.cpg"])
+	}
+
+	shp, shx := files["admin1.shp"], files["admin1.shx"]
+	if int(binary.BigEndian.Uint32(shp[24:]))*2 != len(shp) || int(binary.BigEndian.Uint32(shx[24:]))*2 != len(shx) {
+		t.Errorf("file lengths in headers do not match")
+	}
+	if shapeType := binary.LittleEndian.Uint32(shp[32:]); shapeType != shapePolygon {
+		t.Errorf("expected polygon shape type, got %d", shapeType)
+	}
+	// Second record is a null shape: 8 byte record header and a 4 byte shape type
+	second := int(binary.BigEndian.Uint32(shx[108:])) * 2
+	if binary.LittleEndian.Uint32(shp[second+8:]) != shapeNull {
+		t.Errorf("expected a null shape for an empty geometry")
+	}
+
+	dbf := files["admin1.dbf"]
+	if records := binary.LittleEndian.Uint32(dbf[4:]); records != 2 {
+		t.Errorf("expected 2 records, got %d", records)
+	}
+	names := []string{
+		strings.TrimRight(string(dbf[32:43]), "\x00"),
+		strings.TrimRight(string(dbf[64:75]), "\x00"),
+		strings.TrimRight(string(dbf[96:107]), "\x00"),
+	}
+	if names[0] != "name" || names[1] != "admin_leve" || names[2] != "admin_lev1" {
+		t.Errorf("unexpected field names %v", names)
+	}
+	if width := dbf[32+16]; width != dbfMaxCharWidth {
+		t.Errorf("expected the name field capped at %d bytes, got %d", dbfMaxCharWidth, width)
+	}
+	headerLength := int(binary.LittleEndian.Uint16(dbf[8:]))
+	name := dbf[headerLength+1 : headerLength+1+dbfMaxCharWidth]
+	if !utf8.Valid(bytes.TrimRight(name, " ")) {
+		t.Errorf("truncated Thai text is not valid UTF-8")
+	}
+}
+
+func TestOrientRing(t *testing.T) {
+	counterClockwise := Ring{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}
+
+	if outer := orientRing(counterClockwise, true); outer[1] != (Point{0, 1}) {
+		t.Errorf("expected the outer ring reversed to clockwise, got %v", outer)
+	}
+	if hole := orientRing(counterClockwise, false); hole[1] != (Point{1, 0}) {
+		t.Errorf("expected the hole kept counter-clockwise, got %v", hole)
+	}
+}
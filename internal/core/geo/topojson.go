@@ -0,0 +1,270 @@
+package geo
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Feature is a polygonal feature to be encoded into a topology
+type Feature struct {
+	ID         string
+	Properties map[string]any
+	Geometry   MultiPolygon
+}
+
+// Topology is a TopoJSON topology object
+type Topology struct {
+	Type      string                         `json:"type"`
+	BBox      []float64                      `json:"bbox,omitempty"`
+	Transform *Transform                     `json:"transform,omitempty"`
+	Objects   map[string]*GeometryCollection `json:"objects"`
+	Arcs      [][]Point                      `json:"arcs"`
+}
+
+// Transform maps quantized integer positions back to longitude and latitude
+type Transform struct {
+	Scale     [2]float64 `json:"scale"`
+	Translate [2]float64 `json:"translate"`
+}
+
+// GeometryCollection is a named TopoJSON object holding the encoded features
+type GeometryCollection struct {
+	Type       string          `json:"type"`
+	Geometries []*TopoGeometry `json:"geometries"`
+}
+
+// TopoGeometry is a MultiPolygon referencing shared arcs by index; ~i means arc i reversed
+type TopoGeometry struct {
+	Type       string         `json:"type"`
+	ID         string         `json:"id,omitempty"`
+	Properties map[string]any `json:"properties,omitempty"`
+	Arcs       [][][]int      `json:"arcs"`
+}
+
+// BuildTopology encodes features into a TopoJSON topology where borders shared
+// by neighbouring features are stored once as arcs.
+// A quantization of 0 keeps absolute coordinates; otherwise positions are snapped
+// to a quantization x quantization grid and arcs are delta-encoded.
+func BuildTopology(objectName string, features []Feature, quantization int) *Topology {
+	topology := &Topology{
+		Type:    "Topology",
+		Objects: map[string]*GeometryCollection{},
+		Arcs:    [][]Point{},
+	}
+
+	all := MultiPolygon{}
+	for _, f := range features {
+		all = append(all, f.Geometry...)
+	}
+	minX, minY, maxX, maxY := all.Bounds()
+	if len(all) > 0 && !math.IsInf(minX, 0) {
+		topology.BBox = []float64{minX, minY, maxX, maxY}
+	}
+
+	quantize := func(p Point) Point { return p }
+	if quantization > 1 && topology.BBox != nil {
+		kx, ky := 1.0, 1.0
+		if maxX > minX {
+			kx = float64(quantization-1) / (maxX - minX)
+		}
+		if maxY > minY {
+			ky = float64(quantization-1) / (maxY - minY)
+		}
+		topology.Transform = &Transform{
+			Scale:     [2]float64{1 / kx, 1 / ky},
+			Translate: [2]float64{minX, minY},
+		}
+		quantize = func(p Point) Point {
+			return Point{math.Round((p[0] - minX) * kx), math.Round((p[1] - minY) * ky)}
+		}
+	}
+
+	// Quantize and clean every ring up front so junction detection sees the final positions
+	cleaned := make([]MultiPolygon, len(features))
+	for i, f := range features {
+		cleaned[i] = cleanMultiPolygon(f.Geometry, quantize)
+	}
+
+	junctions := findJunctions(cleaned)
+	index := newArcIndex()
+
+	collection := &GeometryCollection{Type: "GeometryCollection", Geometries: make([]*TopoGeometry, 0, len(features))}
+	for i, f := range features {
+		geometry := &TopoGeometry{
+			Type:       "MultiPolygon",
+			ID:         f.ID,
+			Properties: f.Properties,
+			Arcs:       make([][][]int, 0, len(cleaned[i])),
+		}
+		for _, polygon := range cleaned[i] {
+			rings := make([][]int, 0, len(polygon))
+			for _, ring := range polygon {
+				refs := []int{}
+				for _, arc := range cutRing(ring, junctions) {
+					refs = append(refs, index.add(arc))
+				}
+				rings = append(rings, refs)
+			}
+			geometry.Arcs = append(geometry.Arcs, rings)
+		}
+		collection.Geometries = append(collection.Geometries, geometry)
+	}
+	topology.Objects[objectName] = collection
+
+	for _, arc := range index.arcs {
+		if topology.Transform != nil {
+			arc = deltaEncode(arc)
+		}
+		topology.Arcs = append(topology.Arcs, arc)
+	}
+	return topology
+}
+
+// cleanMultiPolygon quantizes positions and drops repeated points and rings that collapse.
+// A polygon whose exterior ring collapses is dropped entirely.
+func cleanMultiPolygon(mp MultiPolygon, quantize func(Point) Point) MultiPolygon {
+	out := make(MultiPolygon, 0, len(mp))
+	for _, polygon := range mp {
+		cleanedPolygon := make(Polygon, 0, len(polygon))
+		for i, ring := range polygon {
+			cleanedRing := make(Ring, 0, len(ring)+1)
+			for _, p := range ring {
+				q := quantize(p)
+				if len(cleanedRing) > 0 && cleanedRing[len(cleanedRing)-1] == q {
+					continue
+				}
+				cleanedRing = append(cleanedRing, q)
+			}
+			if len(cleanedRing) > 0 && cleanedRing[0] != cleanedRing[len(cleanedRing)-1] {
+				cleanedRing = append(cleanedRing, cleanedRing[0])
+			}
+			if len(cleanedRing) < 4 {
+				if i == 0 {
+					break
+				}
+				continue
+			}
+			cleanedPolygon = append(cleanedPolygon, cleanedRing)
+		}
+		if len(cleanedPolygon) > 0 {
+			out = append(out, cleanedPolygon)
+		}
+	}
+	return out
+}
+
+// findJunctions returns the points where rings stop sharing the same neighbours,
+// i.e. where a shared border starts or ends.
+func findJunctions(geometries []MultiPolygon) map[Point]bool {
+	type neighbours struct{ prev, next Point }
+	visits := map[Point]neighbours{}
+	junctions := map[Point]bool{}
+
+	for _, mp := range geometries {
+		for _, polygon := range mp {
+			for _, ring := range polygon {
+				n := len(ring) - 1
+				for i := 0; i < n; i++ {
+					p := ring[i]
+					prev, next := ring[(i-1+n)%n], ring[(i+1)%n]
+					seen, ok := visits[p]
+					if !ok {
+						visits[p] = neighbours{prev, next}
+						continue
+					}
+					sameForward := seen.prev == prev && seen.next == next
+					sameReverse := seen.prev == next && seen.next == prev
+					if !sameForward && !sameReverse {
+						junctions[p] = true
+					}
+				}
+			}
+		}
+	}
+	return junctions
+}
+
+// cutRing splits a closed ring into arcs at junctions. Rings without junctions
+// become a single closed arc rotated to start at their smallest point so the
+// same ring is recognised when it is shared (e.g. an enclave and its hole).
+func cutRing(ring Ring, junctions map[Point]bool) []Ring {
+	points := ring[:len(ring)-1]
+
+	start := -1
+	for i, p := range points {
+		if junctions[p] {
+			start = i
+			break
+		}
+	}
+	if start == -1 {
+		start = 0
+		for i, p := range points {
+			if p[0] < points[start][0] || (p[0] == points[start][0] && p[1] < points[start][1]) {
+				start = i
+			}
+		}
+		rotated := append(append(Ring{}, points[start:]...), points[:start]...)
+		return []Ring{append(rotated, points[start])}
+	}
+
+	rotated := append(append(Ring{}, points[start:]...), points[:start]...)
+	rotated = append(rotated, points[start])
+
+	arcs := []Ring{}
+	current := Ring{rotated[0]}
+	for _, p := range rotated[1:] {
+		current = append(current, p)
+		if junctions[p] {
+			arcs = append(arcs, current)
+			current = Ring{p}
+		}
+	}
+	return arcs
+}
+
+// arcIndex deduplicates arcs, recognising an arc that was already stored in the opposite direction
+type arcIndex struct {
+	arcs []Ring
+	keys map[string]int
+}
+
+func newArcIndex() *arcIndex {
+	return &arcIndex{keys: map[string]int{}}
+}
+
+func (a *arcIndex) add(arc Ring) int {
+	if i, ok := a.keys[arcKey(arc, false)]; ok {
+		return i
+	}
+	if i, ok := a.keys[arcKey(arc, true)]; ok {
+		return ^i
+	}
+	i := len(a.arcs)
+	a.arcs = append(a.arcs, arc)
+	a.keys[arcKey(arc, false)] = i
+	return i
+}
+
+func arcKey(arc Ring, reverse bool) string {
+	buf := make([]byte, 0, len(arc)*16)
+	for i := range arc {
+		p := arc[i]
+		if reverse {
+			p = arc[len(arc)-1-i]
+		}
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p[0]))
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p[1]))
+	}
+	return string(buf)
+}
+
+func deltaEncode(arc Ring) []Point {
+	out := make([]Point, len(arc))
+	prev := Point{}
+	for i, p := range arc {
+		out[i] = Point{p[0] - prev[0], p[1] - prev[1]}
+		prev = p
+	}
+	return out
+}
@@ -0,0 +1,97 @@
+package geo
+
+import (
+	"testing"
+)
+
+func square(x0, y0, x1, y1 float64) MultiPolygon {
+	return MultiPolygon{{{{x0, y0}, {x1, y0}, {x1, y1}, {x0, y1}, {x0, y0}}}}
+}
+
+func TestBuildTopologySharesBorder(t *testing.T) {
+	features := []Feature{
+		{ID: "A", Geometry: square(0, 0, 1, 1)},
+		{ID: "B", Geometry: square(1, 0, 2, 1)},
+	}
+
+	topology := BuildTopology("admin1", features, 0)
+
+	if len(topology.Arcs) != 3 {
+		t.Fatalf("expected 3 arcs (two outer borders and one shared), got %d", len(topology.Arcs))
+	}
+
+	geometries := topology.Objects["admin1"].Geometries
+	a, b := geometries[0].Arcs[0][0], geometries[1].Arcs[0][0]
+
+	shared := map[int]bool{}
+	for _, ref := range a {
+		if ref < 0 {
+			ref = ^ref
+		}
+		shared[ref] = true
+	}
+	found := false
+	for _, ref := range b {
+		if ref < 0 && shared[^ref] {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected B to reference the border shared with A in reverse, got A=%v B=%v", a, b)
+	}
+}
+
+func TestBuildTopologyQuantization(t *testing.T) {
+	features := []Feature{{ID: "A", Geometry: square(100, 10, 101, 11)}}
+
+	topology := BuildTopology("admin0", features, 11)
+
+	if topology.Transform == nil {
+		t.Fatal("expected a transform when quantization is enabled")
+	}
+	if topology.Transform.Translate != [2]float64{100, 10} {
+		t.Errorf("unexpected translate %v", topology.Transform.Translate)
+	}
+	if topology.Transform.Scale != [2]float64{0.1, 0.1} {
+		t.Errorf("unexpected scale %v", topology.Transform.Scale)
+	}
+
+	// Delta-decoding the single arc must give back a closed ring on the 0..10 grid
+	arc := topology.Arcs[0]
+	var x, y float64
+	for _, p := range arc {
+		x, y = x+p[0], y+p[1]
+		if x < 0 || x > 10 || y < 0 || y > 10 {
+			t.Fatalf("decoded position (%v, %v) outside quantized grid", x, y)
+		}
+	}
+	if x != arc[0][0] || y != arc[0][1] {
+		t.Errorf("expected closed ring, ended at (%v, %v) starting from %v", x, y, arc[0])
+	}
+}
+
+func TestParseMultiPolygon(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		polygons int
+		wantErr  bool
+	}{
+		{"polygon", `{"type":"Polygon","coordinates":[[[0,0],[1,0],[1,1],[0,0]]]}`, 1, false},
+		{"multipolygon", `{"type":"MultiPolygon","coordinates":[[[[0,0],[1,0],[1,1],[0,0]]],[[[2,2],[3,2],[3,3],[2,2]]]]}`, 2, false},
+		{"point", `{"type":"Point","coordinates":[0,0]}`, 0, true},
+		{"empty", ``, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mp, err := ParseMultiPolygon([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMultiPolygon() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(mp) != tt.polygons {
+				t.Errorf("ParseMultiPolygon() got %d polygons, want %d", len(mp), tt.polygons)
+			}
+		})
+	}
+}
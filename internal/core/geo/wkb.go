@@ -0,0 +1,70 @@
+package geo
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// AppendWKB appends the little-endian ISO WKB encoding of the geometry to buf
+func AppendWKB(buf []byte, g Geometry) []byte {
+	buf = appendWKBHeader(buf, g.Type)
+	switch g.Type {
+	case GeometryPoint:
+		if len(g.Points) == 0 {
+			return appendPoint(buf, Point{math.NaN(), math.NaN()})
+		}
+		return appendPoint(buf, g.Points[0])
+	case GeometryLineString:
+		if len(g.Lines) == 0 {
+			return binary.LittleEndian.AppendUint32(buf, 0)
+		}
+		return appendPoints(buf, g.Lines[0])
+	case GeometryPolygon:
+		if len(g.Polygons) == 0 {
+			return binary.LittleEndian.AppendUint32(buf, 0)
+		}
+		return appendRings(buf, g.Polygons[0])
+	case GeometryMultiPoint:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Points)))
+		for _, p := range g.Points {
+			buf = appendPoint(appendWKBHeader(buf, GeometryPoint), p)
+		}
+	case GeometryMultiLineString:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Lines)))
+		for _, line := range g.Lines {
+			buf = appendPoints(appendWKBHeader(buf, GeometryLineString), line)
+		}
+	case GeometryMultiPolygon:
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(g.Polygons)))
+		for _, polygon := range g.Polygons {
+			buf = appendRings(appendWKBHeader(buf, GeometryPolygon), polygon)
+		}
+	}
+	return buf
+}
+
+func appendWKBHeader(buf []byte, t GeometryType) []byte {
+	buf = append(buf, 1) // little endian
+	return binary.LittleEndian.AppendUint32(buf, uint32(t))
+}
+
+func appendPoint(buf []byte, p Point) []byte {
+	buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(p[0]))
+	return binary.LittleEndian.AppendUint64(buf, math.Float64bits(p[1]))
+}
+
+func appendPoints[P ~[]Point](buf []byte, points P) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(points)))
+	for _, p := range points {
+		buf = appendPoint(buf, p)
+	}
+	return buf
+}
+
+func appendRings(buf []byte, polygon Polygon) []byte {
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(polygon)))
+	for _, ring := range polygon {
+		buf = appendPoints(buf, ring)
+	}
+	return buf
+}
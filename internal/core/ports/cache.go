@@ -0,0 +1,15 @@
+package ports
+
+import "context"
+
+// Cache stores computed results that are expensive to rebuild
+type Cache interface {
+	Get(ctx context.Context, key string, dest interface{}) bool
+	Set(ctx context.Context, key string, value interface{})
+}
+
+// CacheStore is the cache administered by the invalidation API
+type CacheStore interface {
+	DeletePattern(ctx context.Context, pattern string) error
+	RenewNamespace(ctx context.Context) (int64, error)
+}
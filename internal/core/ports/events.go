@@ -0,0 +1,13 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+)
+
+// GeofenceEventBus fans geofence events out to every running instance
+type GeofenceEventBus interface {
+	Publish(ctx context.Context, event *domain.GeofenceEvent) error
+	Subscribe(ctx context.Context) (<-chan *domain.GeofenceEvent, error)
+}
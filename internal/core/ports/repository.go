@@ -7,11 +7,21 @@ import (
 )
 
 type AdminAreaRepository interface {
-	List(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error)
-	GetByID(ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error)
-	GetByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error)
-	GetChildren(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error)
+	List(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error)
+	GetByID(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error)
+	GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error)
+	GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error)
 	FilterCoordinatesByBoundary(ctx context.Context, coordinates [][2]float64, boundaryID string, adminLevel int32) ([]*domain.FilteredCoordinate, error)
+	FilterCoordinatesByGeometry(ctx context.Context, coordinates [][2]float64, geometry []byte) ([]*domain.FilteredCoordinate, error)
+	GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error)
+	ClipByBoundaries(ctx context.Context, geometry []byte, adminLevel int32) ([]*domain.ClippedArea, error)
+	LocateCoordinates(ctx context.Context, coordinates [][2]float64, adminLevel int32, parentCode *string) ([]*domain.CoordinateArea, error)
+	ListClippedToBBox(ctx context.Context, adminLevel int32, bbox domain.BBox) ([]*domain.AdminArea, error)
+	PrecomputeSimplified(ctx context.Context, adminLevel int32, mode domain.Simplification, tolerance float64) error
+	GetNeighbors(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminAreaNeighbor, error)
+	PrecomputeAdjacency(ctx context.Context, adminLevel int32) error
+	Crosswalk(ctx context.Context, code string, adminLevel int32, from, to string, opts domain.GeometryOptions) ([]*domain.CrosswalkMatch, error)
+	Stream(ctx context.Context, scope domain.ExportScope, fn func(*domain.AdminArea) error) error
 }
 
 type OSMLineRepository interface {
@@ -19,3 +29,12 @@ type OSMLineRepository interface {
 	GetAddressByRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.LineWithAddress, error)
 	FindNearbyRoads(ctx context.Context, lat float64, lon float64, radius float64, limit int) ([]*domain.OSMLine, error)
 }
+
+type GeofenceRepository interface {
+	Create(ctx context.Context, input domain.GeofenceDefinition) (*domain.Geofence, error)
+	Update(ctx context.Context, id int, input domain.GeofenceDefinition) (*domain.Geofence, error)
+	Delete(ctx context.Context, id int) error
+	GetByID(ctx context.Context, id int, opts domain.GeometryOptions) (*domain.Geofence, error)
+	List(ctx context.Context, opts domain.GeometryOptions) ([]*domain.Geofence, error)
+	Match(ctx context.Context, coordinates [][2]float64, opts domain.GeometryOptions) (map[int][]*domain.Geofence, error)
+}
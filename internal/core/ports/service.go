@@ -2,16 +2,26 @@ package ports
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/hoshina-dev/gapi/internal/core/domain"
 )
 
 type AdminAreaService interface {
-	GetAll(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error)
-	GetByID(ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error)
-	GetByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error)
-	GetChildren(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error)
+	GetAll(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error)
+	GetByID(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error)
+	GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error)
+	GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error)
 	FilterCoordinatesByBoundary(ctx context.Context, coordinates []*domain.Coordinate, boundaryID string, adminLevel int32) ([]*domain.Coordinate, error)
+	FilterCoordinatesByGeometry(ctx context.Context, coordinates []*domain.Coordinate, geometry []byte) ([]*domain.Coordinate, error)
+	GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error)
+	ClipByBoundaries(ctx context.Context, geometry []byte, adminLevel int32) ([]*domain.ClippedArea, error)
+	AggregateCoordinates(ctx context.Context, coordinates []*domain.Coordinate, adminLevel int32, parentCode *string) ([]*domain.AreaAggregate, error)
+	PrecomputeSimplified(ctx context.Context, adminLevels []int32) error
+	GetNeighbors(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminAreaNeighbor, error)
+	PrecomputeAdjacency(ctx context.Context, adminLevels []int32) error
+	Crosswalk(ctx context.Context, code string, adminLevel int32, from, to string, opts domain.GeometryOptions) ([]*domain.CrosswalkMatch, error)
 }
 
 type OSMLineService interface {
@@ -19,3 +29,42 @@ type OSMLineService interface {
 	GetAddressByRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.LineWithAddress, error)
 	FindNearbyRoads(ctx context.Context, lat float64, lon float64, radius float64, limit int) ([]*domain.OSMLine, error)
 }
+
+type ExportService interface {
+	TopoJSON(ctx context.Context, scope domain.ExportScope, quantization int) ([]byte, error)
+	Write(ctx context.Context, scope domain.ExportScope, format domain.ExportFormat, w io.Writer) error
+	WriteRoads(ctx context.Context, searchTerm string, limit int, format domain.ExportFormat, w io.Writer) error
+	ResolveScope(ctx context.Context, scope domain.ExportScope) (domain.ExportScope, error)
+}
+
+type GeofenceService interface {
+	Create(ctx context.Context, input domain.GeofenceDefinition) (*domain.Geofence, error)
+	Update(ctx context.Context, id int, input domain.GeofenceDefinition) (*domain.Geofence, error)
+	Delete(ctx context.Context, id int) error
+	GetByID(ctx context.Context, id int, opts domain.GeometryOptions) (*domain.Geofence, error)
+	List(ctx context.Context, opts domain.GeometryOptions) ([]*domain.Geofence, error)
+	At(ctx context.Context, lat float64, lon float64, opts domain.GeometryOptions) ([]*domain.Geofence, error)
+	Match(ctx context.Context, coordinates []*domain.Coordinate, opts domain.GeometryOptions) ([]*domain.GeofenceMatch, error)
+}
+
+type GeofenceEventService interface {
+	ReportPosition(ctx context.Context, deviceID string, lat float64, lon float64, timestamp time.Time) ([]*domain.GeofenceEvent, error)
+	Subscribe(ctx context.Context, filter domain.GeofenceEventFilter) (<-chan *domain.GeofenceEvent, error)
+	Run(ctx context.Context) error
+}
+
+type CellService interface {
+	Polyfill(ctx context.Context, grid domain.CellGrid, code string, adminLevel int32, resolution int) ([]string, error)
+	CellAreas(ctx context.Context, grid domain.CellGrid, cell string, adminLevel int32) ([]*domain.CellCoverage, error)
+}
+
+type CacheWarmer interface {
+	Warm(ctx context.Context, opts domain.WarmOptions) (*domain.WarmResult, error)
+}
+
+type CacheService interface {
+	InvalidateLevel(ctx context.Context, adminLevel int32) ([]string, error)
+	InvalidateCode(ctx context.Context, code string, adminLevel int32) ([]string, error)
+	InvalidatePrefix(ctx context.Context, prefix string) ([]string, error)
+	RenewNamespace(ctx context.Context) (int64, error)
+}
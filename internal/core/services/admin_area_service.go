@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"github.com/hoshina-dev/gapi/internal/core/domain"
 	"github.com/hoshina-dev/gapi/internal/core/ports"
@@ -16,27 +18,42 @@ func NewAdminAreaService(repo ports.AdminAreaRepository) ports.AdminAreaService
 }
 
 // GetAll implements [ports.AdminAreaService].
-func (c *adminAreaService) GetAll(ctx context.Context, adminLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	return c.repo.List(ctx, adminLevel, tolerance)
+func (c *adminAreaService) GetAll(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	return c.repo.List(ctx, adminLevel, opts)
 }
 
 // GetByID implements [ports.AdminAreaService].
-func (c *adminAreaService) GetByID(ctx context.Context, id int, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	return c.repo.GetByID(ctx, id, adminLevel, tolerance)
+func (c *adminAreaService) GetByID(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	return c.repo.GetByID(ctx, id, adminLevel, opts)
 }
 
 // GetByCode implements [ports.AdminAreaService].
-func (c *adminAreaService) GetByCode(ctx context.Context, code string, adminLevel int32, tolerance *float64) (*domain.AdminArea, error) {
-	return c.repo.GetByCode(ctx, code, adminLevel, tolerance)
+func (c *adminAreaService) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	return c.repo.GetByCode(ctx, code, adminLevel, opts)
 }
 
 // GetChildren implements [ports.AdminAreaService].
-func (c *adminAreaService) GetChildren(ctx context.Context, parentCode string, childLevel int32, tolerance *float64) ([]*domain.AdminArea, error) {
-	return c.repo.GetChildren(ctx, parentCode, childLevel, tolerance)
+func (c *adminAreaService) GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	return c.repo.GetChildren(ctx, parentCode, childLevel, opts)
 }
 
 // FilterCoordinatesByBoundary implements [ports.AdminAreaService].
 func (c *adminAreaService) FilterCoordinatesByBoundary(ctx context.Context, coordinates []*domain.Coordinate, boundaryID string, adminLevel int32) ([]*domain.Coordinate, error) {
+	return filterCoordinates(coordinates, func(coords [][2]float64) ([]*domain.FilteredCoordinate, error) {
+		return c.repo.FilterCoordinatesByBoundary(ctx, coords, boundaryID, adminLevel)
+	})
+}
+
+// FilterCoordinatesByGeometry implements [ports.AdminAreaService].
+func (c *adminAreaService) FilterCoordinatesByGeometry(ctx context.Context, coordinates []*domain.Coordinate, geometry []byte) ([]*domain.Coordinate, error) {
+	return filterCoordinates(coordinates, func(coords [][2]float64) ([]*domain.FilteredCoordinate, error) {
+		return c.repo.FilterCoordinatesByGeometry(ctx, coords, geometry)
+	})
+}
+
+// filterCoordinates passes the coordinates to the repository as (lat, lon) pairs and
+// maps the matching indexes back to the caller's coordinate IDs
+func filterCoordinates(coordinates []*domain.Coordinate, filter func(coords [][2]float64) ([]*domain.FilteredCoordinate, error)) ([]*domain.Coordinate, error) {
 	// Convert domain coordinates to repository format and create index-to-ID mapping
 	coords := make([][2]float64, len(coordinates))
 	idxToID := make(map[int]string, len(coordinates))
@@ -46,7 +63,7 @@ func (c *adminAreaService) FilterCoordinatesByBoundary(ctx context.Context, coor
 	}
 
 	// Call repository
-	filtered, err := c.repo.FilterCoordinatesByBoundary(ctx, coords, boundaryID, adminLevel)
+	filtered, err := filter(coords)
 	if err != nil {
 		return nil, err
 	}
@@ -63,3 +80,95 @@ func (c *adminAreaService) FilterCoordinatesByBoundary(ctx context.Context, coor
 
 	return result, nil
 }
+
+// GetMetrics implements [ports.AdminAreaService].
+func (c *adminAreaService) GetMetrics(ctx context.Context, ids []int, adminLevel int32) (map[int]*domain.AdminAreaMetrics, error) {
+	return c.repo.GetMetrics(ctx, ids, adminLevel)
+}
+
+// ClipByBoundaries implements [ports.AdminAreaService].
+func (c *adminAreaService) ClipByBoundaries(ctx context.Context, geometry []byte, adminLevel int32) ([]*domain.ClippedArea, error) {
+	return c.repo.ClipByBoundaries(ctx, geometry, adminLevel)
+}
+
+// AggregateCoordinates implements [ports.AdminAreaService].
+// The repository places each coordinate in an area; counts, value sums and densities
+// are totalled here, busiest area first. Coordinates outside every area are left out.
+func (c *adminAreaService) AggregateCoordinates(ctx context.Context, coordinates []*domain.Coordinate, adminLevel int32, parentCode *string) ([]*domain.AreaAggregate, error) {
+	coords := make([][2]float64, len(coordinates))
+	for i, coord := range coordinates {
+		coords[i] = [2]float64{coord.Lat, coord.Lon}
+	}
+
+	located, err := c.repo.LocateCoordinates(ctx, coords, adminLevel, parentCode)
+	if err != nil {
+		return nil, err
+	}
+
+	byGID := make(map[string]*domain.AreaAggregate)
+	result := []*domain.AreaAggregate{}
+	for _, loc := range located {
+		agg, ok := byGID[loc.GID]
+		if !ok {
+			agg = &domain.AreaAggregate{GID: loc.GID, Name: loc.Name, AdminLevel: adminLevel, AreaKm2: loc.AreaKm2}
+			byGID[loc.GID] = agg
+			result = append(result, agg)
+		}
+		agg.Count++
+		if value := coordinates[loc.Idx].Value; value != nil {
+			if agg.Sum == nil {
+				agg.Sum = new(float64)
+			}
+			*agg.Sum += *value
+		}
+	}
+
+	for _, agg := range result {
+		if agg.AreaKm2 > 0 {
+			agg.Density = float64(agg.Count) / agg.AreaKm2
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].GID < result[j].GID
+	})
+	return result, nil
+}
+
+// PrecomputeSimplified implements [ports.AdminAreaService].
+// It stores simplified geometries for every level, simplification mode and zoom tolerance.
+func (c *adminAreaService) PrecomputeSimplified(ctx context.Context, adminLevels []int32) error {
+	modes := []domain.Simplification{domain.SimplificationStandard, domain.SimplificationCoverage}
+	for _, level := range adminLevels {
+		for _, mode := range modes {
+			for _, tolerance := range domain.ZoomTolerances {
+				if err := c.repo.PrecomputeSimplified(ctx, level, mode, tolerance); err != nil {
+					return fmt.Errorf("precompute %s simplification for level %d tolerance %g: %w", mode, level, tolerance, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// GetNeighbors implements [ports.AdminAreaService].
+func (c *adminAreaService) GetNeighbors(ctx context.Context, id int, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminAreaNeighbor, error) {
+	return c.repo.GetNeighbors(ctx, id, adminLevel, opts)
+}
+
+// PrecomputeAdjacency implements [ports.AdminAreaService].
+func (c *adminAreaService) PrecomputeAdjacency(ctx context.Context, adminLevels []int32) error {
+	for _, level := range adminLevels {
+		if err := c.repo.PrecomputeAdjacency(ctx, level); err != nil {
+			return fmt.Errorf("precompute adjacency for level %d: %w", level, err)
+		}
+	}
+	return nil
+}
+
+// Crosswalk implements [ports.AdminAreaService].
+func (c *adminAreaService) Crosswalk(ctx context.Context, code string, adminLevel int32, from, to string, opts domain.GeometryOptions) ([]*domain.CrosswalkMatch, error) {
+	return c.repo.Crosswalk(ctx, code, adminLevel, from, to, opts)
+}
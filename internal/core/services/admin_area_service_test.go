@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// locateRepo places coordinates in areas by index; other methods are not used by the aggregation
+type locateRepo struct {
+	ports.AdminAreaRepository
+	located []*domain.CoordinateArea
+}
+
+func (r *locateRepo) LocateCoordinates(ctx context.Context, coordinates [][2]float64, adminLevel int32, parentCode *string) ([]*domain.CoordinateArea, error) {
+	return r.located, nil
+}
+
+func TestAggregateCoordinates(t *testing.T) {
+	repo := &locateRepo{located: []*domain.CoordinateArea{
+		{Idx: 0, GID: "THA.10_1", Name: "Chiang Mai", AreaKm2: 20000},
+		{Idx: 1, GID: "THA.1_1", Name: "Bangkok", AreaKm2: 1500},
+		{Idx: 2, GID: "THA.1_1", Name: "Bangkok", AreaKm2: 1500},
+		{Idx: 3, GID: "THA.1_1", Name: "Bangkok", AreaKm2: 1500},
+	}}
+	service := NewAdminAreaService(repo)
+
+	two, five := 2.0, 5.0
+	coordinates := []*domain.Coordinate{
+		{ID: "a", Lat: 18.8, Lon: 98.9},
+		{ID: "b", Lat: 13.7, Lon: 100.5, Value: &two},
+		{ID: "c", Lat: 13.8, Lon: 100.6},
+		{ID: "d", Lat: 13.7, Lon: 100.6, Value: &five},
+		{ID: "e", Lat: 0, Lon: 0}, // outside every area
+	}
+
+	aggregates, err := service.AggregateCoordinates(context.Background(), coordinates, 1, nil)
+	if err != nil {
+		t.Fatalf("AggregateCoordinates() error = %v", err)
+	}
+	if len(aggregates) != 2 {
+		t.Fatalf("got %d aggregates, want 2", len(aggregates))
+	}
+
+	bangkok, chiangMai := aggregates[0], aggregates[1]
+	if bangkok.GID != "THA.1_1" || bangkok.Count != 3 || bangkok.AdminLevel != 1 {
+		t.Errorf("first aggregate = %+v, want 3 coordinates in Bangkok", bangkok)
+	}
+	if bangkok.Sum == nil || *bangkok.Sum != 7 {
+		t.Errorf("Bangkok sum = %v, want 7", bangkok.Sum)
+	}
+	if bangkok.Density != 3.0/1500 {
+		t.Errorf("Bangkok density = %v, want %v", bangkok.Density, 3.0/1500)
+	}
+	if chiangMai.Count != 1 || chiangMai.Sum != nil {
+		t.Errorf("second aggregate = %+v, want 1 unweighted coordinate in Chiang Mai", chiangMai)
+	}
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// adminAreaKeyKinds prefix the keys of the caching repository that start with the
+// dataset-suffixed kind and the admin level, e.g. admin_area:list@gadm41:1:...
+var adminAreaKeyKinds = []string{"admin_area", "admin_area:list", "admin_area:code", "admin_area:children", "admin_area:metrics", "admin_area:neighbors"}
+
+type cacheService struct {
+	store ports.CacheStore
+	repo  ports.AdminAreaRepository
+}
+
+// NewCacheService drops cached results by admin level, code or key prefix, for the
+// dataset of the request. repo resolves codes to the areas whose entries are keyed by ID.
+func NewCacheService(store ports.CacheStore, repo ports.AdminAreaRepository) ports.CacheService {
+	return &cacheService{store: store, repo: repo}
+}
+
+// InvalidateLevel implements [ports.CacheService].
+// It drops the areas, lists, children, metrics and neighbours of the level, the cells
+// polyfilled from it, the crosswalks of the level and the exports of the level.
+func (s *cacheService) InvalidateLevel(ctx context.Context, adminLevel int32) ([]string, error) {
+	level := strconv.Itoa(int(adminLevel))
+	var patterns []string
+	for _, kind := range adminAreaKeyKinds {
+		patterns = append(patterns, escapePattern(domain.DatasetKey(ctx, kind)+":"+level+":")+"*")
+	}
+	patterns = append(patterns,
+		escapePattern(domain.DatasetKey(ctx, "cells")+":")+"*:"+level+":*",
+		"admin_area:crosswalk:*:"+level+":*",
+		escapePattern(domain.DatasetKey(ctx, "export:topojson")+":"+level+":")+"*",
+	)
+	return s.deletePatterns(ctx, patterns)
+}
+
+// InvalidateCode implements [ports.CacheService].
+// It drops the entries of the area, the lists and the parent's children it appears
+// in, its own children and cells, and the exports of its level.
+func (s *cacheService) InvalidateCode(ctx context.Context, code string, adminLevel int32) ([]string, error) {
+	area, err := s.repo.GetByCode(ctx, code, adminLevel, domain.GeometryOptions{Omit: true})
+	if err != nil {
+		return nil, err
+	}
+	level := strconv.Itoa(int(adminLevel))
+	id := strconv.Itoa(area.ID)
+
+	key := func(kind string, parts ...string) string {
+		return escapePattern(domain.DatasetKey(ctx, kind) + ":" + strings.Join(parts, ":"))
+	}
+	patterns := []string{
+		key("admin_area", level, id) + ":*",
+		key("admin_area:metrics", level, id),
+		key("admin_area:metrics", level, id) + "|*", // in a renewed namespace
+		key("admin_area:neighbors", level, id) + ":*",
+		key("admin_area:list", level) + ":*",
+		key("admin_area:children", strconv.Itoa(int(adminLevel)+1), area.ISOCode) + ":*",
+		escapePattern(domain.DatasetKey(ctx, "cells")+":") + "*:" + level + ":" + escapePattern(area.ISOCode) + ":*",
+		escapePattern(domain.DatasetKey(ctx, "cells")+":") + "*:areas:" + level + ":*",
+		"admin_area:crosswalk:*:" + level + ":" + escapePattern(area.ISOCode) + ":*",
+		key("export:topojson", level) + ":*",
+	}
+	// The area may have been requested by a code without its version suffix
+	for _, requested := range slices.Compact([]string{code, area.ISOCode}) {
+		patterns = append(patterns, key("admin_area:code", level, requested)+":*")
+	}
+	if area.ParentCode != nil {
+		patterns = append(patterns, key("admin_area:children", level, *area.ParentCode)+":*")
+	}
+	return s.deletePatterns(ctx, patterns)
+}
+
+// InvalidatePrefix implements [ports.CacheService].
+// The prefix must start with one of the cache's key prefixes so unrelated keys are kept.
+func (s *cacheService) InvalidatePrefix(ctx context.Context, prefix string) ([]string, error) {
+	for _, keyPrefix := range domain.CacheKeyPrefixes {
+		if strings.HasPrefix(prefix, keyPrefix) {
+			return s.deletePatterns(ctx, []string{escapePattern(prefix) + "*"})
+		}
+	}
+	return nil, fmt.Errorf("prefix must start with one of %v", domain.CacheKeyPrefixes)
+}
+
+// RenewNamespace implements [ports.CacheService].
+func (s *cacheService) RenewNamespace(ctx context.Context) (int64, error) {
+	return s.store.RenewNamespace(ctx)
+}
+
+func (s *cacheService) deletePatterns(ctx context.Context, patterns []string) ([]string, error) {
+	for _, pattern := range patterns {
+		if err := s.store.DeletePattern(ctx, pattern); err != nil {
+			return nil, fmt.Errorf("delete cached %s: %w", pattern, err)
+		}
+	}
+	return patterns, nil
+}
+
+// escapePattern escapes the glob characters of a literal key part
+func escapePattern(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
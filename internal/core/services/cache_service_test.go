@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// patternStore records the deleted patterns
+type patternStore struct {
+	patterns []string
+	version  int64
+}
+
+func (s *patternStore) DeletePattern(ctx context.Context, pattern string) error {
+	s.patterns = append(s.patterns, pattern)
+	return nil
+}
+
+func (s *patternStore) RenewNamespace(ctx context.Context) (int64, error) {
+	s.version++
+	return s.version, nil
+}
+
+// deleted reports whether a deleted pattern matches the key; Redis globs match like path.Match for keys without slashes
+func (s *patternStore) deleted(key string) bool {
+	for _, pattern := range s.patterns {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// codeRepo resolves codes to one province of Chiang Mai
+type codeRepo struct {
+	ports.AdminAreaRepository
+}
+
+func (r *codeRepo) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	parent := "THA"
+	return &domain.AdminArea{ID: 7, ISOCode: "THA.10_1", AdminLevel: adminLevel, ParentCode: &parent}, nil
+}
+
+func TestCacheService_InvalidateLevel(t *testing.T) {
+	store := &patternStore{}
+	service := NewCacheService(store, &codeRepo{})
+
+	if _, err := service.InvalidateLevel(domain.WithDataset(context.Background(), "gadm41"), 1); err != nil {
+		t.Fatalf("InvalidateLevel() error = %v", err)
+	}
+
+	for _, key := range []string{
+		"admin_area@gadm41:1:7:<nil>",
+		"admin_area:list@gadm41:1:nogeom|v3",
+		"admin_area:code@gadm41:1:THA.10_1:0.0100000000",
+		"admin_area:children@gadm41:1:THA:<nil>",
+		"admin_area:metrics@gadm41:1:7",
+		"admin_area:neighbors@gadm41:1:7:nogeom",
+		"cells@gadm41:GEOHASH:1:THA.10_1:5",
+		"cells@gadm41:GEOHASH:areas:1:w5q",
+		"export:topojson@gadm41:1:*:STANDARD:<nil>:10000",
+	} {
+		if !store.deleted(key) {
+			t.Errorf("key %s was not invalidated", key)
+		}
+	}
+	for _, key := range []string{
+		"admin_area:list:1:nogeom",         // default dataset
+		"admin_area:list@gadm41:2:nogeom",  // other level
+		"admin_area:list@gadm410:1:nogeom", // other dataset
+		"cells@gadm41:GEOHASH:2:THA.10.1_1:5",
+		"export:topojson:1:*:STANDARD:<nil>:10000", // default dataset
+		"cache_version:gadm41",
+	} {
+		if store.deleted(key) {
+			t.Errorf("key %s was invalidated", key)
+		}
+	}
+}
+
+func TestCacheService_InvalidateCode(t *testing.T) {
+	store := &patternStore{}
+	service := NewCacheService(store, &codeRepo{})
+
+	if _, err := service.InvalidateCode(context.Background(), "THA.10", 1); err != nil {
+		t.Fatalf("InvalidateCode() error = %v", err)
+	}
+
+	for _, key := range []string{
+		"admin_area:1:7:<nil>",
+		"admin_area:metrics:1:7",
+		"admin_area:metrics:1:7|v2",
+		"admin_area:neighbors:1:7:nogeom",
+		"admin_area:code:1:THA.10:nogeom",
+		"admin_area:code:1:THA.10_1:<nil>",
+		"admin_area:list:1:<nil>",
+		"admin_area:children:1:THA:<nil>",
+		"admin_area:children:2:THA.10_1:nogeom",
+		"cells:GEOHASH:1:THA.10_1:6",
+	} {
+		if !store.deleted(key) {
+			t.Errorf("key %s was not invalidated", key)
+		}
+	}
+	for _, key := range []string{
+		"admin_area:1:70:<nil>",
+		"admin_area:metrics:1:70",
+		"admin_area:code:1:THA.11_1:<nil>",
+		"admin_area:children:1:LAO:<nil>",
+		"cells:GEOHASH:1:THA.11_1:6",
+	} {
+		if store.deleted(key) {
+			t.Errorf("key %s was invalidated", key)
+		}
+	}
+}
+
+func TestCacheService_InvalidatePrefix(t *testing.T) {
+	store := &patternStore{}
+	service := NewCacheService(store, &codeRepo{})
+
+	patterns, err := service.InvalidatePrefix(context.Background(), "admin_area:list*")
+	if err != nil {
+		t.Fatalf("InvalidatePrefix() error = %v", err)
+	}
+	if len(patterns) != 1 || patterns[0] != `admin_area:list\**` {
+		t.Errorf("patterns = %v, want the prefix escaped", patterns)
+	}
+
+	for _, prefix := range []string{"", "cache_version", "sessions:"} {
+		if _, err := service.InvalidatePrefix(context.Background(), prefix); err == nil {
+			t.Errorf("InvalidatePrefix(%q) error = nil, want an error", prefix)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// warmProgressInterval is how often a running warm-up logs its progress
+const warmProgressInterval = 10 * time.Second
+
+type cacheWarmer struct {
+	repo ports.AdminAreaRepository
+}
+
+// NewCacheWarmer loads admin areas through repo, the caching repository, so the first
+// requests after a deploy or an import are served from the cache
+func NewCacheWarmer(repo ports.AdminAreaRepository) ports.CacheWarmer {
+	return &cacheWarmer{repo: repo}
+}
+
+// warmTask is one repository request of a warm-up
+type warmTask struct {
+	name  string
+	fetch func(ctx context.Context) error
+}
+
+// Warm implements [ports.CacheWarmer]. Every level is listed in every preset, then the
+// children of every area of the level above are fetched in every preset. Failed requests
+// are logged and counted; only a cancelled context stops the warm-up.
+func (w *cacheWarmer) Warm(ctx context.Context, opts domain.WarmOptions) (*domain.WarmResult, error) {
+	result := &domain.WarmResult{}
+	concurrency := max(opts.Concurrency, 1)
+
+	var lists []warmTask
+	for _, level := range opts.Levels {
+		for _, preset := range opts.Presets {
+			lists = append(lists, warmTask{
+				name: fmt.Sprintf("list level %d", level),
+				fetch: func(ctx context.Context) error {
+					_, err := w.repo.List(ctx, level, preset)
+					return err
+				},
+			})
+		}
+	}
+	w.run(ctx, "lists", lists, concurrency, result)
+
+	var children []warmTask
+	for _, level := range opts.Levels {
+		if level == 0 || ctx.Err() != nil {
+			continue
+		}
+		parents, err := w.repo.List(ctx, level-1, domain.GeometryOptions{Omit: true})
+		result.Requests++
+		if err != nil {
+			log.Printf("Cache warm-up: failed to list the parents of level %d: %v", level, err)
+			result.Failed++
+			continue
+		}
+		for _, parent := range parents {
+			for _, preset := range opts.Presets {
+				children = append(children, warmTask{
+					name: fmt.Sprintf("children of %s", parent.ISOCode),
+					fetch: func(ctx context.Context) error {
+						_, err := w.repo.GetChildren(ctx, parent.ISOCode, level, preset)
+						return err
+					},
+				})
+			}
+		}
+	}
+	w.run(ctx, "children", children, concurrency, result)
+
+	return result, ctx.Err()
+}
+
+// run fetches the tasks with at most concurrency in flight, logging the progress
+func (w *cacheWarmer) run(ctx context.Context, phase string, tasks []warmTask, concurrency int, result *domain.WarmResult) {
+	if len(tasks) == 0 {
+		return
+	}
+	log.Printf("Cache warm-up: fetching %d %s", len(tasks), phase)
+
+	var done, failed atomic.Int64
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(warmProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				log.Printf("Cache warm-up: %s %d/%d", phase, done.Load(), len(tasks))
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			if err := task.fetch(ctx); err != nil {
+				if ctx.Err() == nil {
+					log.Printf("Cache warm-up: %s failed: %v", task.name, err)
+				}
+				failed.Add(1)
+			}
+			done.Add(1)
+		}()
+	}
+	wg.Wait()
+	close(stop)
+
+	result.Requests += int(done.Load())
+	result.Failed += int(failed.Load())
+	log.Printf("Cache warm-up: fetched %d/%d %s, %d failed", done.Load(), len(tasks), phase, failed.Load())
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// warmRepo serves two countries with two provinces each and records the requests
+type warmRepo struct {
+	ports.AdminAreaRepository
+	mu       sync.Mutex
+	calls    []string
+	inFlight atomic.Int32
+	peak     atomic.Int32
+	failList int32 // level whose List fails, -1 for none
+}
+
+func (r *warmRepo) enter(call string) {
+	n := r.inFlight.Add(1)
+	for {
+		peak := r.peak.Load()
+		if n <= peak || r.peak.CompareAndSwap(peak, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	r.inFlight.Add(-1)
+
+	r.mu.Lock()
+	r.calls = append(r.calls, call)
+	r.mu.Unlock()
+}
+
+func (r *warmRepo) List(ctx context.Context, adminLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	r.enter(fmt.Sprintf("list %d omit=%t", adminLevel, opts.Omit))
+	if adminLevel == r.failList {
+		return nil, errors.New("boom")
+	}
+	if adminLevel == 0 {
+		return []*domain.AdminArea{{ISOCode: "THA"}, {ISOCode: "LAO"}}, nil
+	}
+	return []*domain.AdminArea{{ISOCode: "THA.1_1"}, {ISOCode: "THA.2_1"}, {ISOCode: "LAO.1_1"}, {ISOCode: "LAO.2_1"}}, nil
+}
+
+func (r *warmRepo) GetChildren(ctx context.Context, parentCode string, childLevel int32, opts domain.GeometryOptions) ([]*domain.AdminArea, error) {
+	r.enter(fmt.Sprintf("children %s %d omit=%t", parentCode, childLevel, opts.Omit))
+	return nil, nil
+}
+
+func TestCacheWarmer_ListsLevelsAndChildrenOfEveryParent(t *testing.T) {
+	repo := &warmRepo{failList: -1}
+	warmer := NewCacheWarmer(repo)
+
+	result, err := warmer.Warm(context.Background(), domain.WarmOptions{
+		Levels:      []int32{0, 1},
+		Presets:     []domain.GeometryOptions{{}, {Omit: true}},
+		Concurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("Warm() error = %v", err)
+	}
+
+	want := []string{
+		"children LAO 1 omit=false", "children LAO 1 omit=true",
+		"children THA 1 omit=false", "children THA 1 omit=true",
+		"list 0 omit=false", "list 0 omit=true", "list 0 omit=true", // the last one lists the parents of level 1
+		"list 1 omit=false", "list 1 omit=true",
+	}
+	sort.Strings(repo.calls)
+	if fmt.Sprint(repo.calls) != fmt.Sprint(want) {
+		t.Errorf("calls = %v, want %v", repo.calls, want)
+	}
+	if result.Requests != len(want) || result.Failed != 0 {
+		t.Errorf("result = %+v, want %d requests and no failures", result, len(want))
+	}
+	if peak := repo.peak.Load(); peak > 2 {
+		t.Errorf("peak concurrency = %d, want at most 2", peak)
+	}
+}
+
+func TestCacheWarmer_CountsFailuresAndContinues(t *testing.T) {
+	repo := &warmRepo{failList: 0}
+	warmer := NewCacheWarmer(repo)
+
+	result, err := warmer.Warm(context.Background(), domain.WarmOptions{
+		Levels:  []int32{0, 1, 2},
+		Presets: []domain.GeometryOptions{{}},
+	})
+	if err != nil {
+		t.Fatalf("Warm() error = %v", err)
+	}
+
+	// list 0 and the parents of level 1 fail; list 1, list 2, the parents of level 2
+	// and the children of its four parents succeed
+	if result.Failed != 2 || result.Requests != 9 {
+		t.Errorf("result = %+v, want 9 requests with 2 failures", result)
+	}
+}
+
+func TestCacheWarmer_StopsWhenCancelled(t *testing.T) {
+	repo := &warmRepo{failList: -1}
+	warmer := NewCacheWarmer(repo)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := warmer.Warm(ctx, domain.WarmOptions{
+		Levels:  []int32{0, 1},
+		Presets: []domain.GeometryOptions{{}},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Warm() error = %v, want context.Canceled", err)
+	}
+	if result.Requests != 0 {
+		t.Errorf("requests = %d, want none after cancellation", result.Requests)
+	}
+}
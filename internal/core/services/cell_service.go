@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/geo"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// maxPolyfillCells caps the cells of one polyfill, counted over the area's bounding box
+const maxPolyfillCells = 100000
+
+type cellService struct {
+	repo  ports.AdminAreaRepository
+	cache ports.Cache
+}
+
+// NewCellService indexes admin areas with grid cells. Cells are computed in Go from the
+// geometries of the repository and cached per dataset and area or cell, and so per resolution.
+func NewCellService(repo ports.AdminAreaRepository, cache ports.Cache) ports.CellService {
+	return &cellService{repo: repo, cache: cache}
+}
+
+// Polyfill implements [ports.CellService]. A cell belongs to the area when its centre
+// lies inside the full-resolution geometry.
+func (s *cellService) Polyfill(ctx context.Context, grid domain.CellGrid, code string, adminLevel int32, resolution int) ([]string, error) {
+	var polyfill func(geo.MultiPolygon, int, int) ([]string, error)
+	switch grid {
+	case domain.CellGridGeohash:
+		polyfill = geo.GeohashPolyfill
+	case domain.CellGridH3:
+		polyfill = geo.H3Polyfill
+	default:
+		return nil, fmt.Errorf("unsupported cell grid: %s", grid)
+	}
+
+	// Cells are keyed by the ISO code the area is invalidated by, not the code asked for,
+	// which may lack the version suffix
+	area, err := s.repo.GetByCode(ctx, code, adminLevel, domain.GeometryOptions{Omit: true})
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := fmt.Sprintf("%s:%s:%d:%s:%d", domain.DatasetKey(ctx, "cells"), grid, adminLevel, area.ISOCode, resolution)
+	var cells []string
+	if s.cache.Get(ctx, cacheKey, &cells) {
+		return cells, nil
+	}
+
+	area, err = s.repo.GetByCode(ctx, area.ISOCode, adminLevel, domain.GeometryOptions{})
+	if err != nil {
+		return nil, err
+	}
+	polygons, err := geo.ParseMultiPolygon(area.Geometry)
+	if err != nil {
+		return nil, fmt.Errorf("decode geometry of %s: %w", code, err)
+	}
+	cells, err = polyfill(polygons, resolution, maxPolyfillCells)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(ctx, cacheKey, cells)
+	return cells, nil
+}
+
+// CellAreas implements [ports.CellService]. Areas are loaded clipped to the bounding box
+// of the cell, and the coverage of each area is its planar share of the cell, which is
+// exact enough at the scale of a cell. Areas are ordered by coverage, largest first.
+func (s *cellService) CellAreas(ctx context.Context, grid domain.CellGrid, cell string, adminLevel int32) ([]*domain.CellCoverage, error) {
+	var bounds geo.Rect
+	var coverage func(geo.MultiPolygon) float64
+	switch grid {
+	case domain.CellGridGeohash:
+		rect, err := geo.DecodeGeohash(cell)
+		if err != nil {
+			return nil, err
+		}
+		bounds = rect
+		coverage = func(polygons geo.MultiPolygon) float64 {
+			return polygons.AreaWithin(rect) / rect.Area()
+		}
+	case domain.CellGridH3:
+		canonical, boundary, err := geo.DecodeH3(cell)
+		if err != nil {
+			return nil, err
+		}
+		cell = canonical
+		bounds = geo.Rect{MinX: math.Inf(1), MinY: math.Inf(1), MaxX: math.Inf(-1), MaxY: math.Inf(-1)}
+		for _, p := range boundary {
+			bounds.MinX, bounds.MaxX = math.Min(bounds.MinX, p[0]), math.Max(bounds.MaxX, p[0])
+			bounds.MinY, bounds.MaxY = math.Min(bounds.MinY, p[1]), math.Max(bounds.MaxY, p[1])
+		}
+		coverage = func(polygons geo.MultiPolygon) float64 {
+			return polygons.AreaWithinConvex(boundary) / boundary.Area()
+		}
+	default:
+		return nil, fmt.Errorf("unsupported cell grid: %s", grid)
+	}
+
+	cacheKey := fmt.Sprintf("%s:%s:areas:%d:%s", domain.DatasetKey(ctx, "cells"), grid, adminLevel, cell)
+	var coverages []*domain.CellCoverage
+	if s.cache.Get(ctx, cacheKey, &coverages) {
+		return coverages, nil
+	}
+
+	areas, err := s.repo.ListClippedToBBox(ctx, adminLevel, domain.BBox{
+		MinLon: bounds.MinX,
+		MinLat: bounds.MinY,
+		MaxLon: bounds.MaxX,
+		MaxLat: bounds.MaxY,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	coverages = []*domain.CellCoverage{}
+	for _, area := range areas {
+		polygons, err := geo.ParseMultiPolygon(area.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("decode geometry of %s: %w", area.ISOCode, err)
+		}
+		fraction := math.Min(coverage(polygons), 1)
+		// Areas only touching the cell have nothing inside it
+		if fraction <= 0 {
+			continue
+		}
+		coverages = append(coverages, &domain.CellCoverage{Area: area, Fraction: fraction})
+	}
+	sort.SliceStable(coverages, func(i, j int) bool {
+		return coverages[i].Fraction > coverages[j].Fraction
+	})
+
+	s.cache.Set(ctx, cacheKey, coverages)
+	return coverages, nil
+}
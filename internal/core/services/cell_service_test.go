@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// gridRepo serves one area by code and the areas overlapping any box, counting the
+// lookups that load the geometry
+type gridRepo struct {
+	ports.AdminAreaRepository
+	area    *domain.AdminArea
+	clipped []*domain.AdminArea
+	lookups int
+}
+
+func (r *gridRepo) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	if !opts.Omit {
+		r.lookups++
+	}
+	return r.area, nil
+}
+
+func (r *gridRepo) ListClippedToBBox(ctx context.Context, adminLevel int32, bbox domain.BBox) ([]*domain.AdminArea, error) {
+	return r.clipped, nil
+}
+
+// mapCache keeps entries as JSON, like the Redis cache
+type mapCache map[string][]byte
+
+func (c mapCache) Get(ctx context.Context, key string, dest interface{}) bool {
+	data, ok := c[key]
+	return ok && json.Unmarshal(data, dest) == nil
+}
+
+func (c mapCache) Set(ctx context.Context, key string, value interface{}) {
+	c[key], _ = json.Marshal(value)
+}
+
+func TestPolyfillIsCachedPerResolution(t *testing.T) {
+	repo := &gridRepo{area: &domain.AdminArea{
+		ISOCode:  "XX.1_1",
+		Geometry: []byte(`{"type":"Polygon","coordinates":[[[0,0],[90,0],[90,45],[0,45],[0,0]]]}`),
+	}}
+	service := NewCellService(repo, mapCache{})
+
+	// The unversioned code resolves to the same area and shares its cache entry
+	for _, code := range []string{"XX.1_1", "XX.1"} {
+		cells, err := service.Polyfill(context.Background(), domain.CellGridGeohash, code, 1, 1)
+		if err != nil {
+			t.Fatalf("Polyfill() error = %v", err)
+		}
+		if !slices.Equal(cells, []string{"s", "t"}) {
+			t.Errorf("cells = %v, want [s t]", cells)
+		}
+	}
+	if repo.lookups != 1 {
+		t.Errorf("area loaded %d times, want once", repo.lookups)
+	}
+
+	if _, err := service.Polyfill(context.Background(), domain.CellGridGeohash, "XX.1_1", 1, 2); err != nil {
+		t.Fatalf("Polyfill() error = %v", err)
+	}
+	if repo.lookups != 2 {
+		t.Errorf("a new resolution should load the area again, loaded %d times", repo.lookups)
+	}
+}
+
+func TestCellAreasCoverage(t *testing.T) {
+	// Cell "s" spans 0..45 degrees in both directions; west covers three quarters of it
+	repo := &gridRepo{clipped: []*domain.AdminArea{
+		{ISOCode: "XX.2_1", Geometry: []byte(`{"type":"MultiPolygon","coordinates":[[[[33.75,0],[45,0],[45,45],[33.75,45],[33.75,0]]]]}`)},
+		{ISOCode: "XX.1_1", Geometry: []byte(`{"type":"MultiPolygon","coordinates":[[[[0,0],[33.75,0],[33.75,45],[0,45],[0,0]]]]}`)},
+		{ISOCode: "XX.3_1", Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)},
+	}}
+	service := NewCellService(repo, mapCache{})
+
+	coverages, err := service.CellAreas(context.Background(), domain.CellGridGeohash, "s", 1)
+	if err != nil {
+		t.Fatalf("CellAreas() error = %v", err)
+	}
+	if len(coverages) != 2 {
+		t.Fatalf("got %d coverages, want 2 without the touching area", len(coverages))
+	}
+	if coverages[0].Area.ISOCode != "XX.1_1" || math.Abs(coverages[0].Fraction-0.75) > 1e-9 {
+		t.Errorf("first coverage = %s %v, want XX.1_1 0.75", coverages[0].Area.ISOCode, coverages[0].Fraction)
+	}
+	if math.Abs(coverages[1].Fraction-0.25) > 1e-9 {
+		t.Errorf("second coverage = %v, want 0.25", coverages[1].Fraction)
+	}
+
+	if _, err := service.CellAreas(context.Background(), domain.CellGridGeohash, "sa", 1); err == nil {
+		t.Error("expected an error for an invalid geohash")
+	}
+}
+
+func TestCellAreasH3Coverage(t *testing.T) {
+	// The boxes split res 9 cell 8928308280fffff at its centre's longitude, -122.41846
+	repo := &gridRepo{clipped: []*domain.AdminArea{
+		{ISOCode: "XX.1_1", Geometry: []byte(`{"type":"Polygon","coordinates":[[[-122.5,37.7],[-122.41846,37.7],[-122.41846,37.8],[-122.5,37.8],[-122.5,37.7]]]}`)},
+		{ISOCode: "XX.2_1", Geometry: []byte(`{"type":"Polygon","coordinates":[[[-122.41846,37.7],[-122.3,37.7],[-122.3,37.8],[-122.41846,37.8],[-122.41846,37.7]]]}`)},
+	}}
+	cache := mapCache{}
+	service := NewCellService(repo, cache)
+
+	coverages, err := service.CellAreas(context.Background(), domain.CellGridH3, "0x8928308280FFFFF", 1)
+	if err != nil {
+		t.Fatalf("CellAreas() error = %v", err)
+	}
+	if len(coverages) != 2 {
+		t.Fatalf("got %d coverages, want 2", len(coverages))
+	}
+	for _, coverage := range coverages {
+		if math.Abs(coverage.Fraction-0.5) > 0.01 {
+			t.Errorf("coverage of %s = %v, want half the cell", coverage.Area.ISOCode, coverage.Fraction)
+		}
+	}
+	if _, ok := cache["cells:H3:areas:1:8928308280fffff"]; !ok {
+		t.Errorf("cache keys = %v, want the cell in canonical form", slices.Collect(maps.Keys(cache)))
+	}
+
+	if _, err := service.CellAreas(context.Background(), domain.CellGridH3, "s", 1); err == nil {
+		t.Error("expected an error for an invalid H3 cell")
+	}
+}
+
+func TestPolyfillH3(t *testing.T) {
+	repo := &gridRepo{area: &domain.AdminArea{
+		ISOCode:  "XX.1_1",
+		Geometry: []byte(`{"type":"Polygon","coordinates":[[[-122.5,37.7],[-122.3,37.7],[-122.3,37.8],[-122.5,37.8],[-122.5,37.7]]]}`),
+	}}
+	service := NewCellService(repo, mapCache{})
+
+	cells, err := service.Polyfill(context.Background(), domain.CellGridH3, "XX.1_1", 1, 7)
+	if err != nil {
+		t.Fatalf("Polyfill() error = %v", err)
+	}
+	// About 190 km² over 5.16 km² cells
+	if len(cells) < 30 || len(cells) > 45 || !slices.Contains(cells, "872830828ffffff") {
+		t.Errorf("got %d cells %v, want about 37 including 872830828ffffff", len(cells), cells)
+	}
+}
@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/geo"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+type exportService struct {
+	repo     ports.AdminAreaRepository
+	lineRepo ports.OSMLineRepository
+	cache    ports.Cache
+}
+
+func NewExportService(repo ports.AdminAreaRepository, lineRepo ports.OSMLineRepository, cache ports.Cache) ports.ExportService {
+	return &exportService{repo: repo, lineRepo: lineRepo, cache: cache}
+}
+
+// adminColumns are the attributes of admin areas in tabular export formats
+var adminColumns = []geo.Column{
+	{Name: "gid", Type: geo.ColumnString},
+	{Name: "name", Type: geo.ColumnString},
+	{Name: "admin_level", Type: geo.ColumnInt},
+	{Name: "parent_code", Type: geo.ColumnString},
+}
+
+// roadColumns are the attributes of roads in tabular export formats
+var roadColumns = []geo.Column{
+	{Name: "name", Type: geo.ColumnString},
+	{Name: "name_en", Type: geo.ColumnString},
+}
+
+// TopoJSON implements [ports.ExportService].
+// The encoded topology is cached since building shared arcs for a whole level is expensive.
+func (s *exportService) TopoJSON(ctx context.Context, scope domain.ExportScope, quantization int) ([]byte, error) {
+	cacheKey := fmt.Sprintf("%s:%s:%d", domain.DatasetKey(ctx, "export:topojson"), scopeCacheKey(scope), quantization)
+
+	var cached []byte
+	if s.cache.Get(ctx, cacheKey, &cached) {
+		return cached, nil
+	}
+
+	areas, err := s.loadAreas(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]geo.Feature, 0, len(areas))
+	for _, area := range areas {
+		geometry, err := geo.ParseMultiPolygon(area.Geometry)
+		if err != nil {
+			return nil, fmt.Errorf("parse geometry of %s: %w", area.ISOCode, err)
+		}
+		features = append(features, geo.Feature{
+			ID:         area.ISOCode,
+			Properties: areaProperties(area),
+			Geometry:   geometry,
+		})
+	}
+
+	topology := geo.BuildTopology(adminLayerName(scope), features, quantization)
+	data, err := json.Marshal(topology)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(ctx, cacheKey, data)
+	return data, nil
+}
+
+// Write implements [ports.ExportService].
+// GeoJSON and KML features are encoded as they are read from the repository, nothing is
+// buffered per export. FlatGeobuf and GeoPackage spool encoded features to a temporary
+// file until their index and metadata are known. Shapefile sizes its attribute fields to
+// the longest value, so the level is collected first.
+func (s *exportService) Write(ctx context.Context, scope domain.ExportScope, format domain.ExportFormat, w io.Writer) error {
+	features := func(fn func(geoJSONFeature) error) error {
+		return s.repo.Stream(ctx, scope, func(area *domain.AdminArea) error {
+			return fn(newAreaFeature(area))
+		})
+	}
+
+	switch format {
+	case domain.ExportFormatGeoJSON:
+		return writeFeatureCollection(features, w)
+	case domain.ExportFormatGeoJSONSeq:
+		return writeFeatureSeq(features, w)
+	case domain.ExportFormatKML:
+		return s.writeAdminKML(ctx, scope, w)
+	case domain.ExportFormatFlatGeobuf:
+		return geo.StreamFlatGeobuf(w, adminSchema(scope), s.adminRecords(ctx, scope))
+	case domain.ExportFormatGeoPackage:
+		return geo.StreamGeoPackage(w, adminSchema(scope), s.adminRecords(ctx, scope))
+	case domain.ExportFormatShapefile:
+		layer, err := s.adminLayer(ctx, scope)
+		if err != nil {
+			return err
+		}
+		return writeLayer(layer, format, w)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ResolveScope implements [ports.ExportService].
+// The parent is looked up and replaced by its GID, so an unknown or ambiguous code fails
+// before anything is written.
+func (s *exportService) ResolveScope(ctx context.Context, scope domain.ExportScope) (domain.ExportScope, error) {
+	if scope.ParentCode == nil {
+		return scope, nil
+	}
+	parent, err := s.repo.GetByCode(ctx, *scope.ParentCode, scope.AdminLevel-1, domain.GeometryOptions{Omit: true})
+	if err != nil {
+		return domain.ExportScope{}, err
+	}
+	scope.ParentCode = &parent.ISOCode
+	return scope, nil
+}
+
+// WriteRoads implements [ports.ExportService].
+func (s *exportService) WriteRoads(ctx context.Context, searchTerm string, limit int, format domain.ExportFormat, w io.Writer) error {
+	lines, err := s.lineRepo.SearchRoadName(ctx, searchTerm, limit)
+	if err != nil {
+		return err
+	}
+
+	features := func(fn func(geoJSONFeature) error) error {
+		for _, line := range lines {
+			if err := fn(newRoadFeature(line)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	switch format {
+	case domain.ExportFormatGeoJSON:
+		return writeFeatureCollection(features, w)
+	case domain.ExportFormatGeoJSONSeq:
+		return writeFeatureSeq(features, w)
+	case domain.ExportFormatFlatGeobuf, domain.ExportFormatGeoPackage, domain.ExportFormatShapefile, domain.ExportFormatKML:
+		layer, err := roadLayer(lines)
+		if err != nil {
+			return err
+		}
+		return writeLayer(layer, format, w)
+	default:
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// geoJSONFeature reuses the GeoJSON produced by PostGIS as-is for the geometry
+type geoJSONFeature struct {
+	Type       string          `json:"type"`
+	ID         string          `json:"id,omitempty"`
+	Properties map[string]any  `json:"properties"`
+	Geometry   json.RawMessage `json:"geometry"`
+}
+
+func newAreaFeature(area *domain.AdminArea) geoJSONFeature {
+	return geoJSONFeature{
+		Type:       "Feature",
+		ID:         area.ISOCode,
+		Properties: areaProperties(area),
+		Geometry:   area.Geometry,
+	}
+}
+
+func newRoadFeature(line *domain.OSMLine) geoJSONFeature {
+	properties := map[string]any{}
+	if line.Name != nil {
+		properties["name"] = *line.Name
+	}
+	if line.NameEn != nil {
+		properties["nameEn"] = *line.NameEn
+	}
+	return geoJSONFeature{
+		Type:       "Feature",
+		Properties: properties,
+		Geometry:   line.Geometry,
+	}
+}
+
+func writeFeatureCollection(features func(fn func(geoJSONFeature) error) error, w io.Writer) error {
+	if _, err := io.WriteString(w, `{"type":"FeatureCollection","features":[`); err != nil {
+		return err
+	}
+
+	first := true
+	err := features(func(feature geoJSONFeature) error {
+		data, err := json.Marshal(feature)
+		if err != nil {
+			return err
+		}
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "]}\n")
+	return err
+}
+
+func writeFeatureSeq(features func(fn func(geoJSONFeature) error) error, w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	return features(func(feature geoJSONFeature) error {
+		return encoder.Encode(feature)
+	})
+}
+
+// adminSchema is the layer of a scope without its records
+func adminSchema(scope domain.ExportScope) geo.Layer {
+	return geo.Layer{
+		Name:         adminLayerName(scope),
+		GeometryType: geo.GeometryMultiPolygon,
+		Columns:      adminColumns,
+	}
+}
+
+// adminRecords streams the areas of a scope as MultiPolygon records
+func (s *exportService) adminRecords(ctx context.Context, scope domain.ExportScope) geo.RecordSource {
+	return func(fn func(geo.Record) error) error {
+		return s.repo.Stream(ctx, scope, func(area *domain.AdminArea) error {
+			record, err := areaRecord(area)
+			if err != nil {
+				return err
+			}
+			return fn(record)
+		})
+	}
+}
+
+// adminLayer collects the areas of a scope as MultiPolygon records
+func (s *exportService) adminLayer(ctx context.Context, scope domain.ExportScope) (geo.Layer, error) {
+	layer := adminSchema(scope)
+	err := s.adminRecords(ctx, scope)(func(record geo.Record) error {
+		layer.Records = append(layer.Records, record)
+		return nil
+	})
+	return layer, err
+}
+
+// writeAdminKML streams the areas of a scope as KML placemarks
+func (s *exportService) writeAdminKML(ctx context.Context, scope domain.ExportScope, w io.Writer) error {
+	kml := geo.NewKMLWriter(w, adminLayerName(scope), adminColumns)
+	if err := s.adminRecords(ctx, scope)(kml.Write); err != nil {
+		return err
+	}
+	return kml.Close()
+}
+
+func adminLayerName(scope domain.ExportScope) string {
+	return "admin" + strconv.Itoa(int(scope.AdminLevel))
+}
+
+// areaRecord converts an area to a record with the values of adminColumns
+func areaRecord(area *domain.AdminArea) (geo.Record, error) {
+	geometry, err := geo.ParseGeometry(area.Geometry)
+	if err != nil {
+		return geo.Record{}, fmt.Errorf("parse geometry of %s: %w", area.ISOCode, err)
+	}
+	var parentCode any
+	if area.ParentCode != nil {
+		parentCode = *area.ParentCode
+	}
+	return geo.Record{
+		Geometry: geometry.Multi(),
+		Values:   []any{area.ISOCode, area.Name, int64(area.AdminLevel), parentCode},
+	}, nil
+}
+
+// roadLayer converts road search results to MultiLineString records
+func roadLayer(lines []*domain.OSMLine) (geo.Layer, error) {
+	layer := geo.Layer{
+		Name:         "roads",
+		GeometryType: geo.GeometryMultiLineString,
+		Columns:      roadColumns,
+		Records:      make([]geo.Record, 0, len(lines)),
+	}
+	for _, line := range lines {
+		geometry, err := geo.ParseGeometry(line.Geometry)
+		if err != nil {
+			return geo.Layer{}, fmt.Errorf("parse road geometry: %w", err)
+		}
+		if geometry = geometry.Multi(); geometry.Type != geo.GeometryMultiLineString {
+			return geo.Layer{}, fmt.Errorf("unexpected road geometry type: %s", geometry.Type)
+		}
+		var name, nameEn any
+		if line.Name != nil {
+			name = *line.Name
+		}
+		if line.NameEn != nil {
+			nameEn = *line.NameEn
+		}
+		layer.Records = append(layer.Records, geo.Record{Geometry: geometry, Values: []any{name, nameEn}})
+	}
+	return layer, nil
+}
+
+// writeLayer encodes a collected layer in one of the file based formats
+func writeLayer(layer geo.Layer, format domain.ExportFormat, w io.Writer) error {
+	switch format {
+	case domain.ExportFormatGeoPackage:
+		return geo.WriteGeoPackage(w, layer)
+	case domain.ExportFormatShapefile:
+		return geo.WriteShapefile(w, layer)
+	case domain.ExportFormatKML:
+		return geo.WriteKML(w, layer)
+	default:
+		return geo.WriteFlatGeobuf(w, layer)
+	}
+}
+
+// loadAreas fetches a whole level, or only the children of ParentCode when it is set
+func (s *exportService) loadAreas(ctx context.Context, scope domain.ExportScope) ([]*domain.AdminArea, error) {
+	if scope.ParentCode != nil {
+		return s.repo.GetChildren(ctx, *scope.ParentCode, scope.AdminLevel, scope.Geometry)
+	}
+	return s.repo.List(ctx, scope.AdminLevel, scope.Geometry)
+}
+
+func areaProperties(area *domain.AdminArea) map[string]any {
+	properties := map[string]any{
+		"name":       area.Name,
+		"gid":        area.ISOCode,
+		"adminLevel": area.AdminLevel,
+	}
+	if area.ParentCode != nil {
+		properties["parentCode"] = *area.ParentCode
+	}
+	return properties
+}
+
+// scopeCacheKey formats an export scope so equal scopes always share a cache entry
+func scopeCacheKey(scope domain.ExportScope) string {
+	parent := "*"
+	if scope.ParentCode != nil {
+		parent = *scope.ParentCode
+	}
+	tolerance := "<nil>"
+	if scope.Geometry.Tolerance != nil {
+		tolerance = strconv.FormatFloat(*scope.Geometry.Tolerance, 'f', 10, 64)
+	}
+	mode := scope.Geometry.Simplification
+	if mode == "" {
+		mode = domain.SimplificationStandard
+	}
+	return fmt.Sprintf("%d:%s:%s:%s", scope.AdminLevel, parent, mode, tolerance)
+}
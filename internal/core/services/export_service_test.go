@@ -0,0 +1,210 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// streamRepo serves a fixed set of areas through Stream; other methods are not used by the export service
+type streamRepo struct {
+	ports.AdminAreaRepository
+	areas []*domain.AdminArea
+}
+
+func (r *streamRepo) Stream(ctx context.Context, scope domain.ExportScope, fn func(*domain.AdminArea) error) error {
+	for _, area := range r.areas {
+		if err := fn(area); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *streamRepo) GetByCode(ctx context.Context, code string, adminLevel int32, opts domain.GeometryOptions) (*domain.AdminArea, error) {
+	for _, area := range r.areas {
+		if area.AdminLevel == adminLevel && strings.HasPrefix(area.ISOCode, code+"_") {
+			return area, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", domain.ErrBoundaryNotFound, code)
+}
+
+// searchRepo serves fixed road search results
+type searchRepo struct {
+	ports.OSMLineRepository
+	lines []*domain.OSMLine
+}
+
+func (r *searchRepo) SearchRoadName(ctx context.Context, searchTerm string, limit int) ([]*domain.OSMLine, error) {
+	return r.lines, nil
+}
+
+func testAreas() []*domain.AdminArea {
+	parent := "THA"
+	return []*domain.AdminArea{
+		{ID: 1, Name: "Bangkok", ISOCode: "THA.3_1", AdminLevel: 1, ParentCode: &parent, Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)},
+		{ID: 2, Name: "Chiang Mai", ISOCode: "THA.10_1", AdminLevel: 1, ParentCode: &parent, Geometry: []byte(`{"type":"MultiPolygon","coordinates":[]}`)},
+	}
+}
+
+func TestWriteFeatureCollection(t *testing.T) {
+	service := NewExportService(&streamRepo{areas: testAreas()}, nil, nil)
+
+	var buf bytes.Buffer
+	if err := service.Write(context.Background(), domain.ExportScope{AdminLevel: 1}, domain.ExportFormatGeoJSON, &buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	var collection struct {
+		Type     string `json:"type"`
+		Features []struct {
+			ID         string         `json:"id"`
+			Properties map[string]any `json:"properties"`
+			Geometry   map[string]any `json:"geometry"`
+		} `json:"features"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &collection); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if collection.Type != "FeatureCollection" || len(collection.Features) != 2 {
+		t.Fatalf("unexpected collection: %s", buf.String())
+	}
+	if collection.Features[1].ID != "THA.10_1" || collection.Features[1].Properties["parentCode"] != "THA" {
+		t.Errorf("unexpected feature: %+v", collection.Features[1])
+	}
+	if collection.Features[0].Geometry["type"] != "MultiPolygon" {
+		t.Errorf("geometry not passed through: %+v", collection.Features[0].Geometry)
+	}
+}
+
+func TestWriteFeatureSeq(t *testing.T) {
+	service := NewExportService(&streamRepo{areas: testAreas()}, nil, nil)
+
+	var buf bytes.Buffer
+	if err := service.Write(context.Background(), domain.ExportScope{AdminLevel: 1}, domain.ExportFormatGeoJSONSeq, &buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %s", len(lines), buf.String())
+	}
+	for _, line := range lines {
+		var feature map[string]any
+		if err := json.Unmarshal(line, &feature); err != nil || feature["type"] != "Feature" {
+			t.Errorf("invalid feature line %q: %v", line, err)
+		}
+	}
+}
+
+func TestWriteGeoPackage(t *testing.T) {
+	areas := testAreas()
+	areas[0].Geometry = []byte(`{"type":"Polygon","coordinates":[[[100,13],[101,13],[101,14],[100,13]]]}`)
+	service := NewExportService(&streamRepo{areas: areas}, nil, nil)
+
+	var buf bytes.Buffer
+	if err := service.Write(context.Background(), domain.ExportScope{AdminLevel: 1}, domain.ExportFormatGeoPackage, &buf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if !bytes.HasPrefix(buf.Bytes(), []byte("SQLite format 3\x00")) {
+		t.Errorf("output is not a SQLite database")
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("THA.10_1")) {
+		t.Errorf("expected the area codes to be stored")
+	}
+}
+
+func TestWriteRoads(t *testing.T) {
+	name := "Sukhumvit Road"
+	lines := []*domain.OSMLine{
+		{Name: &name, Geometry: []byte(`{"type":"LineString","coordinates":[[100.5,13.7],[100.6,13.7]]}`)},
+	}
+	service := NewExportService(nil, &searchRepo{lines: lines}, nil)
+
+	t.Run("flatgeobuf", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := service.WriteRoads(context.Background(), "sukhumvit", 10, domain.ExportFormatFlatGeobuf, &buf); err != nil {
+			t.Fatalf("WriteRoads() error = %v", err)
+		}
+		if !bytes.HasPrefix(buf.Bytes(), []byte("fgb\x03fgb")) {
+			t.Errorf("output is not a FlatGeobuf file")
+		}
+	})
+
+	t.Run("geojson", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := service.WriteRoads(context.Background(), "sukhumvit", 10, domain.ExportFormatGeoJSON, &buf); err != nil {
+			t.Fatalf("WriteRoads() error = %v", err)
+		}
+		var collection struct {
+			Features []struct {
+				Properties map[string]any `json:"properties"`
+			} `json:"features"`
+		}
+		if err := json.Unmarshal(buf.Bytes(), &collection); err != nil {
+			t.Fatalf("output is not valid JSON: %v", err)
+		}
+		if len(collection.Features) != 1 || collection.Features[0].Properties["name"] != name {
+			t.Errorf("unexpected collection: %s", buf.String())
+		}
+	})
+
+	t.Run("unexpected geometry", func(t *testing.T) {
+		service := NewExportService(nil, &searchRepo{lines: []*domain.OSMLine{
+			{Geometry: []byte(`{"type":"Point","coordinates":[100.5,13.7]}`)},
+		}}, nil)
+		if err := service.WriteRoads(context.Background(), "x", 10, domain.ExportFormatGeoPackage, &bytes.Buffer{}); err == nil {
+			t.Error("expected an error for a point road geometry")
+		}
+	})
+}
+
+func TestWriteKMLAndShapefile(t *testing.T) {
+	areas := testAreas()
+	for _, area := range areas {
+		area.Geometry = []byte(`{"type":"Polygon","coordinates":[[[100,13],[101,13],[101,14],[100,13]]]}`)
+	}
+	service := NewExportService(&streamRepo{areas: areas}, nil, nil)
+
+	var kml bytes.Buffer
+	if err := service.Write(context.Background(), domain.ExportScope{AdminLevel: 1}, domain.ExportFormatKML, &kml); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if bytes.Count(kml.Bytes(), []byte("<Placemark>")) != 2 || !bytes.Contains(kml.Bytes(), []byte("<name>Chiang Mai</name>")) {
+		t.Errorf("unexpected KML: %s", kml.String())
+	}
+
+	var shp bytes.Buffer
+	if err := service.Write(context.Background(), domain.ExportScope{AdminLevel: 1}, domain.ExportFormatShapefile, &shp); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	if !bytes.HasPrefix(shp.Bytes(), []byte("PK")) || !bytes.Contains(shp.Bytes(), []byte("admin1.dbf")) {
+		t.Errorf("output is not a zipped shapefile")
+	}
+}
+
+func TestResolveScope(t *testing.T) {
+	service := NewExportService(&streamRepo{areas: testAreas()}, nil, nil)
+	parent, unknown := "THA.10", "THA.99"
+
+	scope, err := service.ResolveScope(context.Background(), domain.ExportScope{AdminLevel: 2, ParentCode: &parent})
+	if err != nil {
+		t.Fatalf("ResolveScope() error = %v", err)
+	}
+	if scope.ParentCode == nil || *scope.ParentCode != "THA.10_1" {
+		t.Errorf("ParentCode = %v, want the GID THA.10_1", scope.ParentCode)
+	}
+
+	_, err = service.ResolveScope(context.Background(), domain.ExportScope{AdminLevel: 2, ParentCode: &unknown})
+	if !errors.Is(err, domain.ErrBoundaryNotFound) {
+		t.Errorf("ResolveScope() error = %v, want ErrBoundaryNotFound", err)
+	}
+}
@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"log"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// eventBufferSize is how many events a subscriber may fall behind before newer ones are dropped
+const eventBufferSize = 64
+
+// deviceIdleTTL is how long the state of a device that stopped reporting is kept. A device
+// reporting again after that is treated as new and enters the geofences it is inside.
+const deviceIdleTTL = 24 * time.Hour
+
+// deviceSweepInterval is how often idle devices are looked for while Run is consuming the bus
+const deviceSweepInterval = 10 * time.Minute
+
+type geofenceEventService struct {
+	repo    ports.GeofenceRepository
+	bus     ports.GeofenceEventBus
+	idleTTL time.Duration
+
+	mu          sync.Mutex
+	devices     map[string]*deviceState
+	subscribers map[*eventSubscriber]struct{}
+}
+
+// deviceState is the last known containment of a device: the geofences it is inside,
+// by ID with their names, as of its latest report. inside is nil until the device has
+// been placed, and is replaced rather than modified, so a report reads the map it took
+// without holding the lock. report serializes the reports of the device, the other
+// fields are guarded by the service's mu.
+type deviceState struct {
+	report   sync.Mutex
+	pending  int       // reports waiting for or holding report, the state is not evicted meanwhile
+	reported time.Time // timestamp of the latest report or event applied
+	seen     time.Time // server time of the latest activity, for eviction
+	inside   map[int]string
+}
+
+type eventSubscriber struct {
+	filter domain.GeofenceEventFilter
+	events chan *domain.GeofenceEvent
+}
+
+// NewGeofenceEventService tracks devices against the stored geofences. Events are
+// published on the bus and delivered to subscribers as they come back from it, so
+// every instance sees the events raised by the others; Run must be started to
+// consume the bus.
+func NewGeofenceEventService(repo ports.GeofenceRepository, bus ports.GeofenceEventBus) ports.GeofenceEventService {
+	return &geofenceEventService{
+		repo:        repo,
+		bus:         bus,
+		idleTTL:     deviceIdleTTL,
+		devices:     make(map[string]*deviceState),
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// ReportPosition implements [ports.GeofenceEventService]. The geofences containing
+// the position are compared with the device's last known ones: an ENTER event is
+// raised for each new geofence and an EXIT event for each one left. The first
+// report of a device raises ENTER events for every geofence it is inside.
+// Reports of a device are handled one at a time, and a report older than the latest
+// one applied is dropped without raising events.
+func (s *geofenceEventService) ReportPosition(ctx context.Context, deviceID string, lat float64, lon float64, timestamp time.Time) ([]*domain.GeofenceEvent, error) {
+	state := s.acquireDevice(deviceID)
+	defer s.releaseDevice(state)
+
+	s.mu.Lock()
+	stale := timestamp.Before(state.reported)
+	s.mu.Unlock()
+	if stale {
+		return []*domain.GeofenceEvent{}, nil
+	}
+
+	matches, err := s.repo.Match(ctx, [][2]float64{{lat, lon}}, domain.GeometryOptions{Omit: true})
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[int]string, len(matches[0]))
+	for _, geofence := range matches[0] {
+		current[geofence.ID] = geofence.Name
+	}
+
+	// Events from other instances may have moved the state on during the match
+	s.mu.Lock()
+	if timestamp.Before(state.reported) {
+		s.mu.Unlock()
+		return []*domain.GeofenceEvent{}, nil
+	}
+	previous := state.inside
+	state.reported, state.inside = timestamp, current
+	s.mu.Unlock()
+
+	events := []*domain.GeofenceEvent{}
+	newEvent := func(eventType domain.GeofenceEventType, id int, name string) *domain.GeofenceEvent {
+		return &domain.GeofenceEvent{
+			Type:         eventType,
+			DeviceID:     deviceID,
+			GeofenceID:   id,
+			GeofenceName: name,
+			Lat:          lat,
+			Lon:          lon,
+			Timestamp:    timestamp,
+		}
+	}
+	for id, name := range previous {
+		if _, ok := current[id]; !ok {
+			events = append(events, newEvent(domain.GeofenceEventExit, id, name))
+		}
+	}
+	for id, name := range current {
+		if _, ok := previous[id]; !ok {
+			events = append(events, newEvent(domain.GeofenceEventEnter, id, name))
+		}
+	}
+	// Exits come first, so a device moving between adjacent zones leaves one before entering the next
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].Type != events[j].Type {
+			return events[i].Type == domain.GeofenceEventExit
+		}
+		return events[i].GeofenceID < events[j].GeofenceID
+	})
+
+	for _, event := range events {
+		if err := s.bus.Publish(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// Subscribe implements [ports.GeofenceEventService]. The channel is closed when ctx is done.
+func (s *geofenceEventService) Subscribe(ctx context.Context, filter domain.GeofenceEventFilter) (<-chan *domain.GeofenceEvent, error) {
+	sub := &eventSubscriber{filter: filter, events: make(chan *domain.GeofenceEvent, eventBufferSize)}
+
+	s.mu.Lock()
+	s.subscribers[sub] = struct{}{}
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		delete(s.subscribers, sub)
+		close(sub.events)
+		s.mu.Unlock()
+	}()
+	return sub.events, nil
+}
+
+// Run implements [ports.GeofenceEventService]. It consumes the bus until ctx is done,
+// updating the device state with events raised on other instances and delivering
+// every event to the matching subscribers. Devices idle for deviceIdleTTL are forgotten.
+func (s *geofenceEventService) Run(ctx context.Context) error {
+	events, err := s.bus.Subscribe(ctx)
+	if err != nil {
+		return err
+	}
+	sweep := time.NewTicker(deviceSweepInterval)
+	defer sweep.Stop()
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			s.deliver(event)
+		case now := <-sweep.C:
+			s.evictIdle(now)
+		}
+	}
+}
+
+// acquireDevice returns the state of a device, created if needed, holding its report lock
+func (s *geofenceEventService) acquireDevice(deviceID string) *deviceState {
+	s.mu.Lock()
+	state := s.devices[deviceID]
+	if state == nil {
+		state = &deviceState{}
+		s.devices[deviceID] = state
+	}
+	state.pending++
+	state.seen = time.Now()
+	s.mu.Unlock()
+
+	state.report.Lock()
+	return state
+}
+
+func (s *geofenceEventService) releaseDevice(state *deviceState) {
+	state.report.Unlock()
+	s.mu.Lock()
+	state.pending--
+	s.mu.Unlock()
+}
+
+// evictIdle drops the devices without a report or event for idleTTL
+func (s *geofenceEventService) evictIdle(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for deviceID, state := range s.devices {
+		if state.pending == 0 && now.Sub(state.seen) > s.idleTTL {
+			delete(s.devices, deviceID)
+		}
+	}
+}
+
+func (s *geofenceEventService) deliver(event *domain.GeofenceEvent) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Events of the latest report are applied, including the ones this instance raised
+	// itself since applying them again changes nothing; older events arriving late are not
+	state := s.devices[event.DeviceID]
+	if state == nil {
+		state = &deviceState{}
+		s.devices[event.DeviceID] = state
+	}
+	state.seen = time.Now()
+	if !event.Timestamp.Before(state.reported) {
+		// A report in progress may be reading the current map
+		inside := make(map[int]string, len(state.inside)+1)
+		maps.Copy(inside, state.inside)
+		switch event.Type {
+		case domain.GeofenceEventEnter:
+			inside[event.GeofenceID] = event.GeofenceName
+		case domain.GeofenceEventExit:
+			delete(inside, event.GeofenceID)
+		}
+		state.reported, state.inside = event.Timestamp, inside
+	}
+
+	for sub := range s.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			log.Printf("Dropping geofence event for device %s, subscriber is %d events behind", event.DeviceID, eventBufferSize)
+		}
+	}
+}
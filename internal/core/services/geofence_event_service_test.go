@@ -0,0 +1,251 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// zoneRepo places a position in the geofences listed for its latitude
+type zoneRepo struct {
+	ports.GeofenceRepository
+	zones map[float64][]*domain.Geofence
+}
+
+func (r *zoneRepo) Match(ctx context.Context, coordinates [][2]float64, opts domain.GeometryOptions) (map[int][]*domain.Geofence, error) {
+	return map[int][]*domain.Geofence{0: r.zones[coordinates[0][0]]}, nil
+}
+
+// loopbackBus hands published events straight back to its subscriber, like a single instance on Redis
+type loopbackBus struct {
+	events chan *domain.GeofenceEvent
+}
+
+func (b *loopbackBus) Publish(ctx context.Context, event *domain.GeofenceEvent) error {
+	b.events <- event
+	return nil
+}
+
+func (b *loopbackBus) Subscribe(ctx context.Context) (<-chan *domain.GeofenceEvent, error) {
+	return b.events, nil
+}
+
+func TestReportPositionRaisesEnterAndExit(t *testing.T) {
+	north := &domain.Geofence{ID: 1, Name: "north"}
+	south := &domain.Geofence{ID: 2, Name: "south"}
+	repo := &zoneRepo{zones: map[float64][]*domain.Geofence{10: {north}, 20: {south}, 30: {}}}
+	service := NewGeofenceEventService(repo, &loopbackBus{events: make(chan *domain.GeofenceEvent, 10)})
+
+	steps := []struct {
+		lat  float64
+		want []domain.GeofenceEventType
+		ids  []int
+	}{
+		{10, []domain.GeofenceEventType{domain.GeofenceEventEnter}, []int{1}},
+		{10, nil, nil},
+		{20, []domain.GeofenceEventType{domain.GeofenceEventExit, domain.GeofenceEventEnter}, []int{1, 2}},
+		{30, []domain.GeofenceEventType{domain.GeofenceEventExit}, []int{2}},
+	}
+	for i, step := range steps {
+		events, err := service.ReportPosition(context.Background(), "truck-1", step.lat, 100, time.Unix(int64(i), 0))
+		if err != nil {
+			t.Fatalf("step %d: ReportPosition() error = %v", i, err)
+		}
+		if len(events) != len(step.want) {
+			t.Fatalf("step %d: got %d events, want %d", i, len(events), len(step.want))
+		}
+		for j, event := range events {
+			if event.Type != step.want[j] || event.GeofenceID != step.ids[j] || event.DeviceID != "truck-1" {
+				t.Errorf("step %d event %d = %+v, want %s of %d", i, j, event, step.want[j], step.ids[j])
+			}
+		}
+	}
+}
+
+func TestSubscribersReceiveMatchingEvents(t *testing.T) {
+	zone := &domain.Geofence{ID: 1, Name: "zone"}
+	repo := &zoneRepo{zones: map[float64][]*domain.Geofence{10: {zone}}}
+	service := NewGeofenceEventService(repo, &loopbackBus{events: make(chan *domain.GeofenceEvent, 10)})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go service.Run(ctx)
+
+	events, err := service.Subscribe(ctx, domain.GeofenceEventFilter{DeviceIDs: []string{"truck-2"}})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+
+	for _, device := range []string{"truck-1", "truck-2"} {
+		if _, err := service.ReportPosition(ctx, device, 10, 100, time.Now()); err != nil {
+			t.Fatalf("ReportPosition() error = %v", err)
+		}
+	}
+
+	select {
+	case event := <-events:
+		if event.DeviceID != "truck-2" || event.Type != domain.GeofenceEventEnter {
+			t.Errorf("event = %+v, want truck-2 entering", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event delivered")
+	}
+	select {
+	case event := <-events:
+		t.Errorf("unexpected event %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestRemoteEventsUpdateDeviceState(t *testing.T) {
+	zone := &domain.Geofence{ID: 1, Name: "zone"}
+	repo := &zoneRepo{zones: map[float64][]*domain.Geofence{10: {zone}, 20: {}}}
+	bus := &loopbackBus{events: make(chan *domain.GeofenceEvent, 10)}
+	service := NewGeofenceEventService(repo, bus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, _ := service.Subscribe(ctx, domain.GeofenceEventFilter{})
+	go service.Run(ctx)
+
+	// Another instance saw the device enter the zone
+	bus.events <- &domain.GeofenceEvent{Type: domain.GeofenceEventEnter, DeviceID: "truck-1", GeofenceID: 1, GeofenceName: "zone", Timestamp: time.Unix(1, 0)}
+	<-events
+
+	raised, err := service.ReportPosition(ctx, "truck-1", 20, 100, time.Unix(2, 0))
+	if err != nil {
+		t.Fatalf("ReportPosition() error = %v", err)
+	}
+	if len(raised) != 1 || raised[0].Type != domain.GeofenceEventExit || raised[0].GeofenceName != "zone" {
+		t.Errorf("events = %+v, want an exit from the zone entered on the other instance", raised)
+	}
+}
+
+func TestRemoteEventsDuringReports(t *testing.T) {
+	// Many geofences make the comparison of a report long enough to overlap the events
+	zones := make([]*domain.Geofence, 50)
+	for i := range zones {
+		zones[i] = &domain.Geofence{ID: i, Name: fmt.Sprintf("zone %d", i)}
+	}
+	repo := &zoneRepo{zones: map[float64][]*domain.Geofence{10: zones, 20: zones[:25]}}
+	bus := &loopbackBus{events: make(chan *domain.GeofenceEvent, 1000)}
+	service := NewGeofenceEventService(repo, bus)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go service.Run(ctx)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		// Another instance reports the device at the same times, on the bus goroutine
+		for i := range 200 {
+			bus.events <- &domain.GeofenceEvent{Type: domain.GeofenceEventEnter, DeviceID: "truck-1", GeofenceID: 100 + i, GeofenceName: "remote", Timestamp: time.Unix(int64(i), 0)}
+		}
+	}()
+	for i := range 200 {
+		lat := float64(10 + 10*(i%2))
+		if _, err := service.ReportPosition(ctx, "truck-1", lat, 100, time.Unix(int64(i), 0)); err != nil {
+			t.Fatalf("ReportPosition() error = %v", err)
+		}
+	}
+	wg.Wait()
+}
+
+func TestReportPositionDropsStaleReports(t *testing.T) {
+	zone := &domain.Geofence{ID: 1, Name: "zone"}
+	repo := &zoneRepo{zones: map[float64][]*domain.Geofence{10: {zone}, 20: {}}}
+	service := NewGeofenceEventService(repo, &loopbackBus{events: make(chan *domain.GeofenceEvent, 10)})
+
+	if events, _ := service.ReportPosition(context.Background(), "truck-1", 10, 100, time.Unix(2, 0)); len(events) != 1 {
+		t.Fatalf("got %d events, want an enter", len(events))
+	}
+	// Delivered late: the device was outside the zone before it entered
+	events, err := service.ReportPosition(context.Background(), "truck-1", 20, 100, time.Unix(1, 0))
+	if err != nil {
+		t.Fatalf("ReportPosition() error = %v", err)
+	}
+	if len(events) != 0 {
+		t.Errorf("stale report raised %+v", events)
+	}
+	if events, _ := service.ReportPosition(context.Background(), "truck-1", 10, 100, time.Unix(3, 0)); len(events) != 0 {
+		t.Errorf("device is still inside the zone, got %+v", events)
+	}
+}
+
+// slowRepo counts the matches in flight at once
+type slowRepo struct {
+	zoneRepo
+	inFlight, maxInFlight atomic.Int32
+}
+
+func (r *slowRepo) Match(ctx context.Context, coordinates [][2]float64, opts domain.GeometryOptions) (map[int][]*domain.Geofence, error) {
+	n := r.inFlight.Add(1)
+	defer r.inFlight.Add(-1)
+	for {
+		highest := r.maxInFlight.Load()
+		if n <= highest || r.maxInFlight.CompareAndSwap(highest, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	return r.zoneRepo.Match(ctx, coordinates, opts)
+}
+
+func TestReportPositionSerializesReportsOfADevice(t *testing.T) {
+	zone := &domain.Geofence{ID: 1, Name: "zone"}
+	repo := &slowRepo{zoneRepo: zoneRepo{zones: map[float64][]*domain.Geofence{10: {zone}, 20: {}}}}
+	service := NewGeofenceEventService(repo, &loopbackBus{events: make(chan *domain.GeofenceEvent, 100)})
+
+	var wg sync.WaitGroup
+	entered := atomic.Int32{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			events, err := service.ReportPosition(context.Background(), "truck-1", 10, 100, time.Unix(1, 0))
+			if err != nil {
+				t.Errorf("ReportPosition() error = %v", err)
+			}
+			entered.Add(int32(len(events)))
+		}()
+	}
+	wg.Wait()
+
+	if highest := repo.maxInFlight.Load(); highest != 1 {
+		t.Errorf("%d matches of the device ran at once", highest)
+	}
+	if n := entered.Load(); n != 1 {
+		t.Errorf("the device entered the zone %d times", n)
+	}
+}
+
+func TestIdleDevicesAreEvicted(t *testing.T) {
+	zone := &domain.Geofence{ID: 1, Name: "zone"}
+	repo := &zoneRepo{zones: map[float64][]*domain.Geofence{10: {zone}}}
+	service := NewGeofenceEventService(repo, &loopbackBus{events: make(chan *domain.GeofenceEvent, 10)}).(*geofenceEventService)
+
+	service.ReportPosition(context.Background(), "truck-1", 10, 100, time.Unix(1, 0))
+	service.ReportPosition(context.Background(), "truck-2", 10, 100, time.Unix(1, 0))
+	service.devices["truck-1"].seen = time.Now().Add(-deviceIdleTTL - time.Minute)
+
+	service.evictIdle(time.Now())
+
+	if _, ok := service.devices["truck-1"]; ok {
+		t.Error("idle device was kept")
+	}
+	if _, ok := service.devices["truck-2"]; !ok {
+		t.Error("active device was evicted")
+	}
+	// A device reporting again after eviction starts over
+	if events, _ := service.ReportPosition(context.Background(), "truck-1", 10, 100, time.Unix(2, 0)); len(events) != 1 {
+		t.Errorf("got %+v, want the zone entered again", events)
+	}
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+type geofenceService struct {
+	repo ports.GeofenceRepository
+}
+
+func NewGeofenceService(repo ports.GeofenceRepository) ports.GeofenceService {
+	return &geofenceService{repo: repo}
+}
+
+// Create implements [ports.GeofenceService].
+func (s *geofenceService) Create(ctx context.Context, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	input, err := normalizeGeofenceDefinition(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, input)
+}
+
+// Update implements [ports.GeofenceService].
+func (s *geofenceService) Update(ctx context.Context, id int, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	input, err := normalizeGeofenceDefinition(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Update(ctx, id, input)
+}
+
+// Delete implements [ports.GeofenceService].
+func (s *geofenceService) Delete(ctx context.Context, id int) error {
+	return s.repo.Delete(ctx, id)
+}
+
+// GetByID implements [ports.GeofenceService].
+func (s *geofenceService) GetByID(ctx context.Context, id int, opts domain.GeometryOptions) (*domain.Geofence, error) {
+	return s.repo.GetByID(ctx, id, opts)
+}
+
+// List implements [ports.GeofenceService].
+func (s *geofenceService) List(ctx context.Context, opts domain.GeometryOptions) ([]*domain.Geofence, error) {
+	return s.repo.List(ctx, opts)
+}
+
+// At implements [ports.GeofenceService].
+func (s *geofenceService) At(ctx context.Context, lat float64, lon float64, opts domain.GeometryOptions) ([]*domain.Geofence, error) {
+	matches, err := s.repo.Match(ctx, [][2]float64{{lat, lon}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if matches[0] == nil {
+		return []*domain.Geofence{}, nil
+	}
+	return matches[0], nil
+}
+
+// Match implements [ports.GeofenceService]. Every coordinate is returned, with an
+// empty list when it lies outside all geofences.
+func (s *geofenceService) Match(ctx context.Context, coordinates []*domain.Coordinate, opts domain.GeometryOptions) ([]*domain.GeofenceMatch, error) {
+	coords := make([][2]float64, len(coordinates))
+	for i, coord := range coordinates {
+		coords[i] = [2]float64{coord.Lat, coord.Lon}
+	}
+
+	matches, err := s.repo.Match(ctx, coords, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.GeofenceMatch, len(coordinates))
+	for i, coord := range coordinates {
+		geofences := matches[i]
+		if geofences == nil {
+			geofences = []*domain.Geofence{}
+		}
+		result[i] = &domain.GeofenceMatch{Coordinate: coord, Geofences: geofences}
+	}
+	return result, nil
+}
+
+// normalizeGeofenceDefinition trims the name and deduplicates boundary codes, and ensures
+// the geofence is defined by exactly one of a geometry or boundary codes
+func normalizeGeofenceDefinition(input domain.GeofenceDefinition) (domain.GeofenceDefinition, error) {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" {
+		return input, errors.New("geofence name cannot be empty")
+	}
+
+	if len(input.Geometry) > 0 && len(input.BoundaryCodes) > 0 {
+		return input, errors.New("provide either geometry or boundaryCodes, not both")
+	}
+	if len(input.Geometry) == 0 && len(input.BoundaryCodes) == 0 {
+		return input, errors.New("either geometry or boundaryCodes must be provided")
+	}
+
+	var codes []string
+	for _, code := range input.BoundaryCodes {
+		code = strings.TrimSpace(code)
+		if code == "" {
+			return input, errors.New("boundary codes cannot be empty")
+		}
+		if !slices.Contains(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	input.BoundaryCodes = codes
+	return input, nil
+}
@@ -0,0 +1,87 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hoshina-dev/gapi/internal/core/domain"
+	"github.com/hoshina-dev/gapi/internal/core/ports"
+)
+
+// geofenceRepo records created inputs and serves fixed point matches
+type geofenceRepo struct {
+	ports.GeofenceRepository
+	created []domain.GeofenceDefinition
+	matches map[int][]*domain.Geofence
+}
+
+func (r *geofenceRepo) Create(ctx context.Context, input domain.GeofenceDefinition) (*domain.Geofence, error) {
+	r.created = append(r.created, input)
+	return &domain.Geofence{ID: len(r.created), Name: input.Name, BoundaryCodes: input.BoundaryCodes}, nil
+}
+
+func (r *geofenceRepo) Match(ctx context.Context, coordinates [][2]float64, opts domain.GeometryOptions) (map[int][]*domain.Geofence, error) {
+	return r.matches, nil
+}
+
+func TestCreateGeofenceNormalizesInput(t *testing.T) {
+	repo := &geofenceRepo{}
+	service := NewGeofenceService(repo)
+
+	geofence, err := service.Create(context.Background(), domain.GeofenceDefinition{
+		Name:          "  Central zone ",
+		BoundaryCodes: []string{"THA.3_1", " THA.3_1", "THA.10_1"},
+	})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if geofence.Name != "Central zone" {
+		t.Errorf("name = %q, want trimmed", geofence.Name)
+	}
+	if got := repo.created[0].BoundaryCodes; len(got) != 2 || got[0] != "THA.3_1" || got[1] != "THA.10_1" {
+		t.Errorf("boundary codes = %v, want deduplicated", got)
+	}
+}
+
+func TestCreateGeofenceRejectsInvalidInput(t *testing.T) {
+	tests := []struct {
+		name  string
+		input domain.GeofenceDefinition
+	}{
+		{"empty name", domain.GeofenceDefinition{Name: " ", BoundaryCodes: []string{"THA"}}},
+		{"no definition", domain.GeofenceDefinition{Name: "zone"}},
+		{"both definitions", domain.GeofenceDefinition{Name: "zone", Geometry: []byte(`{}`), BoundaryCodes: []string{"THA"}}},
+		{"empty code", domain.GeofenceDefinition{Name: "zone", BoundaryCodes: []string{""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &geofenceRepo{}
+			if _, err := NewGeofenceService(repo).Create(context.Background(), tt.input); err == nil {
+				t.Error("Create() error = nil, want error")
+			}
+			if len(repo.created) != 0 {
+				t.Error("invalid input reached the repository")
+			}
+		})
+	}
+}
+
+func TestMatchGeofencesKeepsEveryCoordinate(t *testing.T) {
+	zone := &domain.Geofence{ID: 7, Name: "zone"}
+	service := NewGeofenceService(&geofenceRepo{matches: map[int][]*domain.Geofence{1: {zone}}})
+
+	coords := []*domain.Coordinate{{ID: "a", Lat: 1, Lon: 1}, {ID: "b", Lat: 2, Lon: 2}}
+	matches, err := service.Match(context.Background(), coords, domain.GeometryOptions{})
+	if err != nil {
+		t.Fatalf("Match() error = %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("len(matches) = %d, want 2", len(matches))
+	}
+	if matches[0].Coordinate.ID != "a" || len(matches[0].Geofences) != 0 || matches[0].Geofences == nil {
+		t.Errorf("matches[0] = %+v, want a with an empty list", matches[0])
+	}
+	if matches[1].Coordinate.ID != "b" || len(matches[1].Geofences) != 1 || matches[1].Geofences[0].ID != 7 {
+		t.Errorf("matches[1] = %+v, want b in geofence 7", matches[1])
+	}
+}